@@ -0,0 +1,223 @@
+package main
+
+import (
+	"context"
+	"corona-api/src/handlers"
+	"corona-api/src/middleware"
+	"corona-api/src/modules/date"
+	"corona-api/src/modules/migration"
+	"corona-api/src/modules/patient"
+	"corona-api/src/service"
+	"database/sql"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+)
+
+// connectDb はクエリログを出力せずにDBへ接続する(出力をパイプで渡せるようにする)
+func connectDb() (*sql.DB, error) {
+	return middleware.ConnectDbWithQueryLog(nil)
+}
+
+func runFetch(ctx context.Context, w io.Writer, flags *flag.FlagSet, args []string) error {
+	output := flags.String("o", "covid19_japan_all.json", "保存先のファイル(- で標準出力)")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	file, err := patient.FetchCovid19JapanAll(ctx)
+	if err != nil {
+		return err
+	}
+	// 取り込めないファイルは保存しない
+	patientDetails, err := patient.ParseCovid19JapanAll(file)
+	if err != nil {
+		return err
+	}
+
+	if *output == "-" {
+		_, err := w.Write(file)
+		return err
+	}
+	if err := os.WriteFile(*output, file, 0o644); err != nil {
+		return fmt.Errorf("os.WriteFile() error: %v", err)
+	}
+	fmt.Fprintf(w, "%v: %d rows\n", *output, len(patientDetails))
+	return nil
+}
+
+func runIngest(ctx context.Context, w io.Writer, flags *flag.FlagSet, args []string) error {
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if flags.NArg() != 1 {
+		flags.Usage()
+		return fmt.Errorf("FILE is required")
+	}
+	path := flags.Arg(0)
+
+	db, err := connectDb()
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	// 取り込み履歴のオブジェクトキーにはファイルのパスを記録する
+	run, err := handlers.IngestPatientDetails(ctx, db, path, func() ([]byte, error) {
+		return os.ReadFile(path)
+	})
+	if run != nil {
+		if writeErr := writeRun(w, *run); writeErr != nil {
+			return writeErr
+		}
+	}
+	return err
+}
+
+func runQuery(ctx context.Context, w io.Writer, flags *flag.FlagSet, args []string) error {
+	area := flags.String("area", "", "都道府県名")
+	startDate := flags.String("start_date", "", "開始日(YYYYMMDD, latest, -30d)")
+	endDate := flags.String("end_date", "", "終了日(YYYYMMDD, latest, -30d)")
+	period := flags.String("period", "", "期間(last_4_weeks, 2022-Q3 など。start_date, end_date の代わりに指定する)")
+	metrics := flags.String("metrics", "", "カンマ区切りの派生指標(day_over_day, week_over_week, doubling_time など。all は全て)")
+	format := flags.String("format", FormatTable, "出力形式(table, json, csv)")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	res, err := service.NewPatientDetailsService(connectDb, date.SystemClock{}).GetPatientDetails(ctx, service.PatientDetailsRequest{
+		Area:      *area,
+		StartDate: *startDate,
+		EndDate:   *endDate,
+		Period:    *period,
+		Metrics:   *metrics,
+	})
+	if err != nil {
+		return err
+	}
+	return writeDetails(w, *format, res)
+}
+
+func runForecast(ctx context.Context, w io.Writer, flags *flag.FlagSet, args []string) error {
+	area := flags.String("area", "", "都道府県名")
+	endDate := flags.String("end_date", "", "学習に使う最後の日(YYYYMMDD, latest, -30d。既定値は latest)")
+	model := flags.String("model", "", "予測モデル(log_linear, holt_winters。既定値は holt_winters)")
+	horizon := flags.String("horizon", "", "予測する日数(1〜14。既定値は14)")
+	window := flags.String("window", "", "学習に使う日数(21〜365。既定値は42)")
+	level := flags.String("level", "", "予測区間の水準(既定値は0.95)")
+	backtest := flags.String("backtest", "", "検証する予測の起点の数(0〜52。1週間ずつ遡る)")
+	format := flags.String("format", FormatTable, "出力形式(table, json, csv)")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	res, err := service.NewForecastService(connectDb, date.SystemClock{}).GetForecast(ctx, service.ForecastRequest{
+		Area:     *area,
+		EndDate:  *endDate,
+		Model:    *model,
+		Horizon:  *horizon,
+		Window:   *window,
+		Level:    *level,
+		Backtest: *backtest,
+	})
+	if err != nil {
+		return err
+	}
+	return writeForecast(w, *format, res)
+}
+
+func runDiff(ctx context.Context, w io.Writer, flags *flag.FlagSet, args []string) error {
+	format := flags.String("format", FormatTable, "出力形式(table, json, csv)")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if flags.NArg() < 1 || flags.NArg() > 2 {
+		flags.Usage()
+		return fmt.Errorf("NEW_FILE is required")
+	}
+
+	var before []patient.Detail
+	if flags.NArg() == 2 {
+		details, err := readPatientDetailsFile(flags.Arg(0))
+		if err != nil {
+			return err
+		}
+		before = details
+	} else {
+		// ファイルを1つだけ指定した場合はDBのデータと比べる
+		db, err := connectDb()
+		if err != nil {
+			return err
+		}
+		defer db.Close()
+		before, err = patient.GetAllPatientDetails(ctx, db)
+		if err != nil {
+			return err
+		}
+	}
+	after, err := readPatientDetailsFile(flags.Arg(flags.NArg() - 1))
+	if err != nil {
+		return err
+	}
+	return writeChanges(w, *format, patient.Diff(before, after))
+}
+
+func readPatientDetailsFile(path string) ([]patient.Detail, error) {
+	file, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("os.ReadFile() error: %v", err)
+	}
+	return patient.ParseCovid19JapanAll(file)
+}
+
+func runMigrate(ctx context.Context, w io.Writer, flags *flag.FlagSet, args []string) error {
+	dir := flags.String("dir", migration.Dir(os.Getenv("DB_DRIVER")), "マイグレーションのディレクトリ")
+	dryRun := flags.Bool("dry-run", false, "適用せずに未適用のマイグレーションを表示する")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	migrations, err := migration.Load(*dir)
+	if err != nil {
+		return err
+	}
+	db, err := connectDb()
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	if *dryRun {
+		pending, err := migration.Pending(ctx, db, migrations)
+		if err != nil {
+			return err
+		}
+		for _, m := range pending {
+			fmt.Fprintf(w, "pending: %v\n", m.Name)
+		}
+		return nil
+	}
+
+	applied, err := migration.Apply(ctx, db, migrations)
+	for _, m := range applied {
+		fmt.Fprintf(w, "applied: %v\n", m.Name)
+	}
+	if err == nil && len(applied) == 0 {
+		fmt.Fprintln(w, "no pending migrations")
+	}
+	return err
+}
+
+func runStatus(ctx context.Context, w io.Writer, flags *flag.FlagSet, args []string) error {
+	format := flags.String("format", FormatTable, "出力形式(table, json)")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	res, err := service.NewStatusService(connectDb).GetStatus(ctx)
+	if err != nil {
+		return err
+	}
+	return writeStatus(w, *format, res)
+}
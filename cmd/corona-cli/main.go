@@ -0,0 +1,75 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"github.com/joho/godotenv"
+	"io"
+	"os"
+)
+
+const (
+	LocalEnvFile = "environments/local.env"
+)
+
+// command はサブコマンド
+type command struct {
+	name    string
+	usage   string
+	summary string
+	run     func(ctx context.Context, w io.Writer, flags *flag.FlagSet, args []string) error
+}
+
+var commands = []command{
+	{name: "fetch", usage: "fetch [-o FILE]", summary: "外部APIからCovid19JapanAllのJSONファイルをダウンロードする", run: runFetch},
+	{name: "ingest", usage: "ingest FILE", summary: "JSONファイルを patient_details へ取り込む", run: runIngest},
+	{name: "query", usage: "query -area AREA -start_date YYYYMMDD -end_date YYYYMMDD [-format table|json|csv]", summary: "感染者数詳細を取得する(GET /patient/details/{area} と同じ)", run: runQuery},
+	{name: "forecast", usage: "forecast -area AREA [-model log_linear|holt_winters] [-horizon DAYS] [-backtest N] [-format table|json|csv]", summary: "感染者数を予測する(GET /patient/forecast と同じ)", run: runForecast},
+	{name: "diff", usage: "diff [-format table|json|csv] [OLD_FILE] NEW_FILE", summary: "DB(または OLD_FILE)と NEW_FILE の差分を表示する", run: runDiff},
+	{name: "migrate", usage: "migrate [-dir DIR] [-dry-run]", summary: "未適用のマイグレーションを適用する", run: runMigrate},
+	{name: "status", usage: "status [-format table|json]", summary: "データの取り込み状況を表示する(GET /status と同じ)", run: runStatus},
+}
+
+// 運用作業用のコマンドラインツール
+//
+//	$ GO_ENV=local go run ./cmd/corona-cli query -area 東京都 -start_date 20220901 -end_date 20220930
+func main() {
+	// ローカル環境の環境変数を読み込む
+	if os.Getenv("GO_ENV") == "local" {
+		if err := godotenv.Load(LocalEnvFile); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+	}
+
+	if len(os.Args) < 2 {
+		usage(os.Stderr)
+		os.Exit(2)
+	}
+	for _, c := range commands {
+		if c.name != os.Args[1] {
+			continue
+		}
+		flags := flag.NewFlagSet(c.name, flag.ExitOnError)
+		flags.Usage = func() {
+			fmt.Fprintf(flags.Output(), "usage: corona-cli %s\n", c.usage)
+			flags.PrintDefaults()
+		}
+		if err := c.run(context.Background(), os.Stdout, flags, os.Args[2:]); err != nil {
+			fmt.Fprintf(os.Stderr, "corona-cli %s: %v\n", c.name, err)
+			os.Exit(1)
+		}
+		return
+	}
+	usage(os.Stderr)
+	os.Exit(2)
+}
+
+func usage(w io.Writer) {
+	fmt.Fprintln(w, "usage: corona-cli COMMAND [OPTIONS]")
+	fmt.Fprintln(w)
+	for _, c := range commands {
+		fmt.Fprintf(w, "  %-8s %s\n", c.name, c.summary)
+	}
+}
@@ -0,0 +1,287 @@
+package main
+
+import (
+	"corona-api/src/modules/date"
+	"corona-api/src/modules/ingestion"
+	"corona-api/src/modules/patient"
+	"corona-api/src/service"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+)
+
+// 出力形式
+const (
+	FormatTable = "table"
+	FormatJSON  = "json"
+	FormatCSV   = "csv"
+)
+
+func unknownFormat(format string) error {
+	return fmt.Errorf("unknown format: %v", format)
+}
+
+func writeJSON(w io.Writer, v interface{}) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(v)
+}
+
+// writeDetails は感染者数詳細を日付順に出力する。json は API のレスポンスと同じ
+// 派生指標は csv と table では指定された指標の列を追加する。異常値があれば異常値の種類(spike, drop)の列を追加する
+func writeDetails(w io.Writer, format string, res service.PatientDetailsResponse) error {
+	details := append([]patient.Detail(nil), res.Details...)
+	sort.Slice(details, func(i, j int) bool {
+		return details[i].Date.Before(details[j].Date)
+	})
+	metrics := selectedMetrics(res.Metrics)
+	anomalies := map[date.Date]string{}
+	for _, a := range res.Anomalies {
+		anomalies[a.Date] = a.Kind
+	}
+
+	switch format {
+	case FormatJSON:
+		body, err := json.Marshal(res)
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintln(w, string(body))
+		return err
+	case FormatCSV:
+		cw := csv.NewWriter(w)
+		header := []string{"date", "area", "value"}
+		for _, metric := range metrics {
+			header = append(header, string(metric))
+		}
+		if len(anomalies) > 0 {
+			header = append(header, "anomaly")
+		}
+		_ = cw.Write(header)
+		for _, pd := range details {
+			record := []string{pd.Date.String(), pd.Area, strconv.Itoa(int(pd.Value))}
+			for _, metric := range metrics {
+				record = append(record, optionalFloat(res.Metrics[pd.Date][metric], -1, ""))
+			}
+			if len(anomalies) > 0 {
+				record = append(record, anomalies[pd.Date])
+			}
+			_ = cw.Write(record)
+		}
+		cw.Flush()
+		return cw.Error()
+	case FormatTable:
+		tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+		header := "DATE\tAREA\tVALUE"
+		for _, metric := range metrics {
+			header += "\t" + strings.ToUpper(string(metric))
+		}
+		if len(anomalies) > 0 {
+			header += "\tANOMALY"
+		}
+		fmt.Fprintln(tw, header)
+		var sum uint64
+		for _, pd := range details {
+			sum += uint64(pd.Value)
+			row := fmt.Sprintf("%s\t%s\t%d", pd.Date, pd.Area, pd.Value)
+			for _, metric := range metrics {
+				row += "\t" + optionalFloat(res.Metrics[pd.Date][metric], 2, "-")
+			}
+			if len(anomalies) > 0 {
+				row += "\t" + anomalies[pd.Date]
+			}
+			fmt.Fprintln(tw, row)
+		}
+		if len(details) > 0 {
+			fmt.Fprintf(tw, "SUM\t\t%d\n", sum)
+			fmt.Fprintf(tw, "AVERAGE\t\t%.2f\n", float64(sum)/float64(len(details)))
+		}
+		if stats := patient.Describe(details, res.Period); stats.Count > 0 {
+			fmt.Fprintf(tw, "MEDIAN\t\t%.2f\n", *stats.Median)
+			fmt.Fprintf(tw, "MIN\t%s\t%d\n", stats.Min.Date, stats.Min.Value)
+			fmt.Fprintf(tw, "MAX\t%s\t%d\n", stats.Max.Date, stats.Max.Value)
+			fmt.Fprintf(tw, "MISSING DAYS\t\t%d\n", stats.MissingDays)
+		}
+		return tw.Flush()
+	}
+	return unknownFormat(format)
+}
+
+// selectedMetrics は出力する派生指標を patient.Metrics の順に返す
+func selectedMetrics(values map[date.Date]patient.MetricValues) []patient.Metric {
+	var metrics []patient.Metric
+	for _, metric := range patient.Metrics {
+		for _, mv := range values {
+			if _, ok := mv[metric]; ok {
+				metrics = append(metrics, metric)
+				break
+			}
+		}
+	}
+	return metrics
+}
+
+// writeForecast は予測を出力する。json は API のレスポンスと同じ
+// table では予測の検証の結果も出力する(csv は予測だけ)
+func writeForecast(w io.Writer, format string, res service.ForecastResponse) error {
+	switch format {
+	case FormatJSON:
+		return writeJSON(w, res)
+	case FormatCSV:
+		cw := csv.NewWriter(w)
+		_ = cw.Write([]string{"date", "area", "value", "lower", "upper"})
+		for _, p := range res.Forecasts {
+			_ = cw.Write([]string{p.Date.String(), res.Area, strconv.FormatFloat(p.Value, 'f', 2, 64), strconv.FormatFloat(p.Lower, 'f', 2, 64), strconv.FormatFloat(p.Upper, 'f', 2, 64)})
+		}
+		cw.Flush()
+		return cw.Error()
+	case FormatTable:
+		fmt.Fprintf(w, "area: %s\nmodel: %s\ntraining period: %s - %s\n\n", res.Area, res.Options.Model, res.TrainingPeriod.Start, res.TrainingPeriod.End)
+		tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+		fmt.Fprintf(tw, "DATE\tVALUE\tLOWER (%g%%)\tUPPER (%g%%)\n", res.Options.Level*100, res.Options.Level*100)
+		for _, p := range res.Forecasts {
+			fmt.Fprintf(tw, "%s\t%.0f\t%.0f\t%.0f\n", p.Date, p.Value, p.Lower, p.Upper)
+		}
+		if err := tw.Flush(); err != nil {
+			return err
+		}
+		if b := res.Backtest; b != nil {
+			fmt.Fprintln(w)
+			tw = tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+			fmt.Fprintf(tw, "backtest:\t%d windows, %d points\n", b.Windows, b.Points)
+			fmt.Fprintf(tw, "MAE:\t%.2f\n", b.MAE)
+			fmt.Fprintf(tw, "RMSE:\t%.2f\n", b.RMSE)
+			fmt.Fprintf(tw, "MAPE:\t%s\n", optionalFloat(b.MAPE, 2, "-"))
+			fmt.Fprintf(tw, "MASE:\t%s\n", optionalFloat(b.MASE, 3, "-"))
+			fmt.Fprintf(tw, "coverage:\t%.3f\n", b.Coverage)
+			return tw.Flush()
+		}
+		return nil
+	}
+	return unknownFormat(format)
+}
+
+type changeOutput struct {
+	Date   date.Date `json:"date"`
+	Area   string    `json:"area"`
+	Before *uint32   `json:"before"`
+	After  *uint32   `json:"after"`
+}
+
+// writeChanges は差分を出力する。追加・削除された行の値は空(json は null)にする
+func writeChanges(w io.Writer, format string, changes []patient.Change) error {
+	switch format {
+	case FormatJSON:
+		outputs := make([]changeOutput, 0, len(changes))
+		for _, c := range changes {
+			outputs = append(outputs, changeOutput{Date: c.Date, Area: c.Area, Before: c.Before, After: c.After})
+		}
+		return writeJSON(w, outputs)
+	case FormatCSV:
+		cw := csv.NewWriter(w)
+		_ = cw.Write([]string{"date", "area", "before", "after"})
+		for _, c := range changes {
+			_ = cw.Write([]string{c.Date.String(), c.Area, optionalValue(c.Before, ""), optionalValue(c.After, "")})
+		}
+		cw.Flush()
+		return cw.Error()
+	case FormatTable:
+		if len(changes) == 0 {
+			_, err := fmt.Fprintln(w, "no changes")
+			return err
+		}
+		tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(tw, "DATE\tAREA\tBEFORE\tAFTER")
+		for _, c := range changes {
+			fmt.Fprintf(tw, "%s\t%s\t%s\t%s\n", c.Date, c.Area, optionalValue(c.Before, "-"), optionalValue(c.After, "-"))
+		}
+		if err := tw.Flush(); err != nil {
+			return err
+		}
+		_, err := fmt.Fprintf(w, "%d rows changed\n", len(changes))
+		return err
+	}
+	return unknownFormat(format)
+}
+
+// optionalFloat は小数点以下 prec 桁(-1 は必要な桁数)で出力する
+func optionalFloat(value *float64, prec int, empty string) string {
+	if value == nil {
+		return empty
+	}
+	return strconv.FormatFloat(*value, 'f', prec, 64)
+}
+
+func optionalValue(value *uint32, empty string) string {
+	if value == nil {
+		return empty
+	}
+	return strconv.Itoa(int(*value))
+}
+
+// writeStatus はデータの取り込み状況を出力する。json は API のレスポンスと同じ
+func writeStatus(w io.Writer, format string, res service.StatusResponse) error {
+	switch format {
+	case FormatJSON:
+		return writeJSON(w, res)
+	case FormatTable:
+		if res.LastSuccessfulRun != nil {
+			if err := writeRun(w, *res.LastSuccessfulRun); err != nil {
+				return err
+			}
+		} else {
+			fmt.Fprintln(w, "last successful run: none")
+		}
+		fmt.Fprintf(w, "latest date: %s\n\n", res.LatestDate)
+
+		areas := make([]string, 0, len(res.Freshness))
+		for area := range res.Freshness {
+			areas = append(areas, area)
+		}
+		sort.Strings(areas)
+		tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(tw, "AREA\tLATEST DATE")
+		for _, area := range areas {
+			fmt.Fprintf(tw, "%s\t%s\n", area, res.Freshness[area])
+		}
+		if err := tw.Flush(); err != nil {
+			return err
+		}
+
+		if len(res.Anomalies) == 0 {
+			_, err := fmt.Fprintln(w, "\nanomalies: none")
+			return err
+		}
+		fmt.Fprintln(w)
+		tw = tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(tw, "DATE\tAREA\tVALUE\tEXPECTED\tSCORE\tANOMALY")
+		for _, a := range res.Anomalies {
+			fmt.Fprintf(tw, "%s\t%s\t%d\t%.0f\t%.1f\t%s\n", a.Date, a.Area, a.Value, a.Expected, a.Score, a.Kind)
+		}
+		return tw.Flush()
+	}
+	return unknownFormat(format)
+}
+
+// writeRun は取り込み履歴を出力する
+func writeRun(w io.Writer, run ingestion.Run) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(tw, "run id:\t%d\n", run.ID)
+	fmt.Fprintf(tw, "object key:\t%s\n", run.ObjectKey)
+	fmt.Fprintf(tw, "status:\t%s\n", run.Status)
+	fmt.Fprintf(tw, "rows:\tread %d, deleted %d, inserted %d\n", run.RowsRead, run.RowsDeleted, run.RowsInserted)
+	fmt.Fprintf(tw, "started at:\t%s\n", run.StartedAt.Format(time.RFC3339))
+	if run.FinishedAt != nil {
+		fmt.Fprintf(tw, "finished at:\t%s\n", run.FinishedAt.Format(time.RFC3339))
+	}
+	if run.ErrorMessage != "" {
+		fmt.Fprintf(tw, "error:\t%s\n", run.ErrorMessage)
+	}
+	return tw.Flush()
+}
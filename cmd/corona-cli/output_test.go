@@ -0,0 +1,110 @@
+package main
+
+import (
+	"bytes"
+	"corona-api/src/modules/analytics"
+	"corona-api/src/modules/date"
+	"corona-api/src/modules/patient"
+	"corona-api/src/service"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestWriteDetails(t *testing.T) {
+	res := service.PatientDetailsResponse{Details: []patient.Detail{
+		{Date: date.MustParse("20220902"), Area: "東京都", Value: 30, Country: patient.DefaultCountry},
+		{Date: date.MustParse("20220901"), Area: "東京都", Value: 10, Country: patient.DefaultCountry},
+	}, Period: date.Range{Start: date.MustParse("20220901"), End: date.MustParse("20220902")}}
+
+	tests := []struct {
+		format string
+		want   string
+	}{
+		{format: FormatCSV, want: "date,area,value\n2022-09-01,東京都,10\n2022-09-02,東京都,30\n"},
+		{format: FormatJSON, want: `{"20220901":10,"20220902":30,"anomalies":[],"area":"東京都","average":20,"calendar":{"20220901":{"weekday":"Thursday","is_holiday":false},"20220902":{"weekday":"Friday","is_holiday":false}},"period":{"start":"2022-09-01","end":"2022-09-02"},"statistics":{"count":2,"missing_days":0,"sum":40,"mean":20,"min":{"date":"2022-09-01","value":10},"max":{"date":"2022-09-02","value":30},"median":20,"std_dev":14.142135623730951,"p25":15,"p75":25,"p90":28},"sum":40}` + "\n"},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.format, func(t *testing.T) {
+			t.Parallel()
+			var buf bytes.Buffer
+			assert.NoError(t, writeDetails(&buf, tt.format, res))
+			assert.Equal(t, tt.want, buf.String())
+		})
+	}
+}
+
+func TestWriteDetails_Metrics(t *testing.T) {
+	diff, percent := 20.0, 200.0
+	res := service.PatientDetailsResponse{
+		Details: []patient.Detail{
+			{Date: date.MustParse("20220901"), Area: "東京都", Value: 10},
+			{Date: date.MustParse("20220902"), Area: "東京都", Value: 30},
+		},
+		Metrics: map[date.Date]patient.MetricValues{
+			date.MustParse("20220901"): {patient.MetricDayOverDayPercent: nil, patient.MetricDayOverDay: nil},
+			date.MustParse("20220902"): {patient.MetricDayOverDayPercent: &percent, patient.MetricDayOverDay: &diff},
+		},
+	}
+
+	var buf bytes.Buffer
+	assert.NoError(t, writeDetails(&buf, FormatCSV, res))
+	assert.Equal(t, "date,area,value,day_over_day,day_over_day_percent\n2022-09-01,東京都,10,,\n2022-09-02,東京都,30,20,200\n", buf.String())
+}
+
+func TestWriteDetails_Anomalies(t *testing.T) {
+	res := service.PatientDetailsResponse{
+		Details: []patient.Detail{
+			{Date: date.MustParse("20220901"), Area: "東京都", Value: 10},
+			{Date: date.MustParse("20220902"), Area: "東京都", Value: 300},
+		},
+		Anomalies: []patient.Anomaly{
+			{Date: date.MustParse("20220902"), Area: "東京都", Value: 300, Expected: 12, Score: 20, Kind: patient.AnomalyKindSpike},
+		},
+	}
+
+	var buf bytes.Buffer
+	assert.NoError(t, writeDetails(&buf, FormatCSV, res))
+	assert.Equal(t, "date,area,value,anomaly\n2022-09-01,東京都,10,\n2022-09-02,東京都,300,spike\n", buf.String())
+}
+
+func TestWriteDetails_UnknownFormat(t *testing.T) {
+	var buf bytes.Buffer
+	assert.Error(t, writeDetails(&buf, "xml", service.PatientDetailsResponse{}))
+}
+
+func TestWriteForecast(t *testing.T) {
+	mase := 0.8
+	res := service.ForecastResponse{
+		Area:           "東京都",
+		TrainingPeriod: date.Range{Start: date.MustParse("20220801"), End: date.MustParse("20220911")},
+		Options:        analytics.ForecastOptions{Model: analytics.ModelHoltWinters, Horizon: 1, Window: 42, Level: 0.95},
+		Forecasts:      []analytics.ForecastPoint{{Date: date.MustParse("20220912"), Value: 1000.4, Lower: 800, Upper: 1250.5}},
+		Backtest:       &analytics.BacktestResult{Model: analytics.ModelHoltWinters, Windows: 2, Points: 2, MAE: 10, RMSE: 12, MASE: &mase, Coverage: 1},
+	}
+
+	var buf bytes.Buffer
+	assert.NoError(t, writeForecast(&buf, FormatCSV, res))
+	assert.Equal(t, "date,area,value,lower,upper\n2022-09-12,東京都,1000.40,800.00,1250.50\n", buf.String())
+
+	buf.Reset()
+	assert.NoError(t, writeForecast(&buf, FormatTable, res))
+	assert.Contains(t, buf.String(), "2022-09-12  1000   800          1250\n")
+	assert.Contains(t, buf.String(), "MAPE:      -\nMASE:      0.800\n")
+}
+
+func TestWriteChanges(t *testing.T) {
+	value := func(v uint32) *uint32 { return &v }
+	changes := []patient.Change{
+		{Date: date.MustParse("20220901"), Area: "東京都", Before: value(10), After: value(12)},
+		{Date: date.MustParse("20220902"), Area: "大阪府", After: value(5)},
+	}
+
+	var buf bytes.Buffer
+	assert.NoError(t, writeChanges(&buf, FormatCSV, changes))
+	assert.Equal(t, "date,area,before,after\n2022-09-01,東京都,10,12\n2022-09-02,大阪府,,5\n", buf.String())
+
+	buf.Reset()
+	assert.NoError(t, writeChanges(&buf, FormatJSON, changes))
+	assert.JSONEq(t, `[{"date":"2022-09-01","area":"東京都","before":10,"after":12},{"date":"2022-09-02","area":"大阪府","before":null,"after":5}]`, buf.String())
+}
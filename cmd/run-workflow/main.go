@@ -0,0 +1,62 @@
+package main
+
+import (
+	"context"
+	"corona-api/src/handlers"
+	"corona-api/src/modules/workflow"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"github.com/joho/godotenv"
+	"log"
+	"os"
+	"time"
+)
+
+const (
+	LocalEnvFile      = "environments/local.env"
+	DefaultDefinition = "step_functions/update_patient_details.json"
+)
+
+// 定期実行のステートマシンをAWSを使わずにローカルで実行する
+//
+//	$ GO_ENV=local go run ./cmd/run-workflow -input '{}'
+func main() {
+	definition := flag.String("definition", DefaultDefinition, "ステートマシン定義(Amazon States Language)のファイル")
+	input := flag.String("input", "{}", "実行の入力(JSON)")
+	flag.Parse()
+
+	// ローカル環境の環境変数を読み込む
+	if os.Getenv("GO_ENV") == "local" {
+		if err := godotenv.Load(LocalEnvFile); err != nil {
+			log.Fatal(err)
+		}
+	}
+
+	data, err := os.ReadFile(*definition)
+	if err != nil {
+		log.Fatal(err)
+	}
+	sm, err := workflow.Parse(data)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	runner := workflow.NewRunner()
+	handlers.RegisterWorkflowTasks(runner)
+
+	executionID := fmt.Sprintf("local-%s", time.Now().Format("20060102150405"))
+	execution, err := runner.Run(context.Background(), sm, executionID, []byte(*input))
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	output, err := json.MarshalIndent(execution, "", "  ")
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Println(string(output))
+	if execution.Status != workflow.ExecutionStatusSucceeded {
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,8 @@
+CREATE TABLE IF NOT EXISTS patient_details (
+    date    INT UNSIGNED NOT NULL,
+    area    VARCHAR(16)  NOT NULL,
+    value   INT UNSIGNED NOT NULL,
+    country VARCHAR(16)  NOT NULL,
+    PRIMARY KEY (area, date)
+) ENGINE = InnoDB
+  DEFAULT CHARSET = utf8mb4;
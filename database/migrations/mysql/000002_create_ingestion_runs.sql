@@ -0,0 +1,15 @@
+CREATE TABLE IF NOT EXISTS ingestion_runs (
+    id            BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
+    object_key    VARCHAR(255)    NOT NULL,
+    content_hash  CHAR(64)        NOT NULL DEFAULT '',
+    status        VARCHAR(16)     NOT NULL,
+    rows_read     INT UNSIGNED    NOT NULL DEFAULT 0,
+    rows_deleted  INT UNSIGNED    NOT NULL DEFAULT 0,
+    rows_inserted INT UNSIGNED    NOT NULL DEFAULT 0,
+    error_message TEXT            NULL,
+    started_at    DATETIME        NOT NULL,
+    finished_at   DATETIME        NULL,
+    PRIMARY KEY (id),
+    KEY idx_ingestion_runs_status_finished_at (status, finished_at)
+) ENGINE = InnoDB
+  DEFAULT CHARSET = utf8mb4;
@@ -0,0 +1,22 @@
+CREATE TABLE IF NOT EXISTS alert_rules (
+    id         BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
+    name       VARCHAR(255)    NOT NULL,
+    area       VARCHAR(16)     NOT NULL DEFAULT '' COMMENT '空の場合は全ての都道府県',
+    kind       VARCHAR(32)     NOT NULL COMMENT 'week_over_week: 7日間平均の前週比(%), level: 7日間平均',
+    threshold  DOUBLE          NOT NULL,
+    enabled    TINYINT(1)      NOT NULL DEFAULT 1,
+    created_at DATETIME        NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (id)
+) ENGINE = InnoDB
+  DEFAULT CHARSET = utf8mb4;
+
+-- 同じアラートを毎日通知しないよう、ルールと都道府県ごとに発火中かどうかを保持する
+CREATE TABLE IF NOT EXISTS alert_states (
+    rule_id         BIGINT UNSIGNED NOT NULL,
+    area            VARCHAR(16)     NOT NULL,
+    active          TINYINT(1)      NOT NULL,
+    last_fired_date INT UNSIGNED    NULL,
+    updated_at      DATETIME        NOT NULL,
+    PRIMARY KEY (rule_id, area)
+) ENGINE = InnoDB
+  DEFAULT CHARSET = utf8mb4;
@@ -0,0 +1,31 @@
+CREATE TABLE IF NOT EXISTS alert_subscriptions (
+    id              BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
+    area            VARCHAR(16)     NOT NULL,
+    metric          VARCHAR(32)     NOT NULL COMMENT 'week_over_week: 7日間平均の前週比(%), level: 7日間平均',
+    threshold       DOUBLE          NOT NULL,
+    webhook_url     VARCHAR(2048)   NOT NULL,
+    secret          CHAR(64)        NOT NULL COMMENT '配信の署名鍵',
+    active          TINYINT(1)      NOT NULL DEFAULT 0 COMMENT '発火中かどうか(同じアラートを毎日配信しない)',
+    last_fired_date INT UNSIGNED    NULL,
+    created_at      DATETIME        NOT NULL,
+    PRIMARY KEY (id),
+    KEY idx_alert_subscriptions_area (area)
+) ENGINE = InnoDB
+  DEFAULT CHARSET = utf8mb4;
+
+CREATE TABLE IF NOT EXISTS alert_deliveries (
+    id              BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
+    subscription_id BIGINT UNSIGNED NOT NULL,
+    payload         TEXT            NOT NULL,
+    status          VARCHAR(16)     NOT NULL COMMENT 'pending, succeeded, failed',
+    attempts        INT UNSIGNED    NOT NULL DEFAULT 0,
+    response_status INT             NULL,
+    error_message   TEXT            NULL,
+    next_attempt_at DATETIME        NULL,
+    created_at      DATETIME        NOT NULL,
+    delivered_at    DATETIME        NULL,
+    PRIMARY KEY (id),
+    KEY idx_alert_deliveries_status_next_attempt_at (status, next_attempt_at),
+    KEY idx_alert_deliveries_subscription_id (subscription_id, id)
+) ENGINE = InnoDB
+  DEFAULT CHARSET = utf8mb4;
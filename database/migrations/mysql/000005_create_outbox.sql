@@ -0,0 +1,37 @@
+CREATE TABLE IF NOT EXISTS webhook_endpoints (
+    id         BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
+    url        VARCHAR(2048)   NOT NULL,
+    secret     CHAR(64)        NOT NULL COMMENT '配信の署名鍵',
+    created_at DATETIME        NOT NULL,
+    PRIMARY KEY (id)
+) ENGINE = InnoDB
+  DEFAULT CHARSET = utf8mb4;
+
+-- データの更新と同じトランザクションで登録するイベント(Lambda が途中で落ちても失われない)
+CREATE TABLE IF NOT EXISTS outbox_events (
+    id            BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
+    event_type    VARCHAR(64)     NOT NULL,
+    payload       TEXT            NOT NULL,
+    created_at    DATETIME        NOT NULL,
+    dispatched_at DATETIME        NULL COMMENT '各 Webhook への配信を登録した日時',
+    PRIMARY KEY (id),
+    KEY idx_outbox_events_dispatched_at (dispatched_at)
+) ENGINE = InnoDB
+  DEFAULT CHARSET = utf8mb4;
+
+CREATE TABLE IF NOT EXISTS webhook_deliveries (
+    id              BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
+    event_id        BIGINT UNSIGNED NOT NULL,
+    endpoint_id     BIGINT UNSIGNED NOT NULL,
+    status          VARCHAR(16)     NOT NULL COMMENT 'pending, succeeded, dead',
+    attempts        INT UNSIGNED    NOT NULL DEFAULT 0,
+    response_status INT             NULL,
+    error_message   TEXT            NULL,
+    next_attempt_at DATETIME        NULL,
+    created_at      DATETIME        NOT NULL,
+    delivered_at    DATETIME        NULL,
+    PRIMARY KEY (id),
+    UNIQUE KEY uk_webhook_deliveries_event_endpoint (event_id, endpoint_id),
+    KEY idx_webhook_deliveries_status_next_attempt_at (status, next_attempt_at)
+) ENGINE = InnoDB
+  DEFAULT CHARSET = utf8mb4;
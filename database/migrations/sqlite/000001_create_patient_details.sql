@@ -0,0 +1,7 @@
+CREATE TABLE IF NOT EXISTS patient_details (
+    date    INTEGER     NOT NULL,
+    area    VARCHAR(16) NOT NULL,
+    value   INTEGER     NOT NULL,
+    country VARCHAR(16) NOT NULL,
+    PRIMARY KEY (area, date)
+);
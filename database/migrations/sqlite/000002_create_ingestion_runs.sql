@@ -0,0 +1,14 @@
+CREATE TABLE IF NOT EXISTS ingestion_runs (
+    id            INTEGER      NOT NULL PRIMARY KEY AUTOINCREMENT,
+    object_key    VARCHAR(255) NOT NULL,
+    content_hash  CHAR(64)     NOT NULL DEFAULT '',
+    status        VARCHAR(16)  NOT NULL,
+    rows_read     INTEGER      NOT NULL DEFAULT 0,
+    rows_deleted  INTEGER      NOT NULL DEFAULT 0,
+    rows_inserted INTEGER      NOT NULL DEFAULT 0,
+    error_message TEXT         NULL,
+    started_at    DATETIME     NOT NULL,
+    finished_at   DATETIME     NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_ingestion_runs_status_finished_at ON ingestion_runs (status, finished_at);
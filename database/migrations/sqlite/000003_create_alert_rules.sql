@@ -0,0 +1,21 @@
+-- area: 空の場合は全ての都道府県
+-- kind: week_over_week: 7日間平均の前週比(%), level: 7日間平均
+CREATE TABLE IF NOT EXISTS alert_rules (
+    id         INTEGER      NOT NULL PRIMARY KEY AUTOINCREMENT,
+    name       VARCHAR(255) NOT NULL,
+    area       VARCHAR(16)  NOT NULL DEFAULT '',
+    kind       VARCHAR(32)  NOT NULL,
+    threshold  DOUBLE       NOT NULL,
+    enabled    BOOLEAN      NOT NULL DEFAULT 1,
+    created_at DATETIME     NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- 同じアラートを毎日通知しないよう、ルールと都道府県ごとに発火中かどうかを保持する
+CREATE TABLE IF NOT EXISTS alert_states (
+    rule_id         INTEGER     NOT NULL,
+    area            VARCHAR(16) NOT NULL,
+    active          BOOLEAN     NOT NULL,
+    last_fired_date INTEGER     NULL,
+    updated_at      DATETIME    NOT NULL,
+    PRIMARY KEY (rule_id, area)
+);
@@ -0,0 +1,29 @@
+CREATE TABLE IF NOT EXISTS alert_subscriptions (
+    id              INTEGER       NOT NULL PRIMARY KEY AUTOINCREMENT,
+    area            VARCHAR(16)   NOT NULL,
+    metric          VARCHAR(32)   NOT NULL,
+    threshold       DOUBLE        NOT NULL,
+    webhook_url     VARCHAR(2048) NOT NULL,
+    secret          CHAR(64)      NOT NULL,
+    active          BOOLEAN       NOT NULL DEFAULT 0,
+    last_fired_date INTEGER       NULL,
+    created_at      DATETIME      NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_alert_subscriptions_area ON alert_subscriptions (area);
+
+CREATE TABLE IF NOT EXISTS alert_deliveries (
+    id              INTEGER     NOT NULL PRIMARY KEY AUTOINCREMENT,
+    subscription_id INTEGER     NOT NULL,
+    payload         TEXT        NOT NULL,
+    status          VARCHAR(16) NOT NULL,
+    attempts        INTEGER     NOT NULL DEFAULT 0,
+    response_status INTEGER     NULL,
+    error_message   TEXT        NULL,
+    next_attempt_at DATETIME    NULL,
+    created_at      DATETIME    NOT NULL,
+    delivered_at    DATETIME    NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_alert_deliveries_status_next_attempt_at ON alert_deliveries (status, next_attempt_at);
+CREATE INDEX IF NOT EXISTS idx_alert_deliveries_subscription_id ON alert_deliveries (subscription_id, id);
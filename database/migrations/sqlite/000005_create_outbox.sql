@@ -0,0 +1,33 @@
+CREATE TABLE IF NOT EXISTS webhook_endpoints (
+    id         INTEGER       NOT NULL PRIMARY KEY AUTOINCREMENT,
+    url        VARCHAR(2048) NOT NULL,
+    secret     CHAR(64)      NOT NULL,
+    created_at DATETIME      NOT NULL
+);
+
+-- データの更新と同じトランザクションで登録するイベント(Lambda が途中で落ちても失われない)
+CREATE TABLE IF NOT EXISTS outbox_events (
+    id            INTEGER     NOT NULL PRIMARY KEY AUTOINCREMENT,
+    event_type    VARCHAR(64) NOT NULL,
+    payload       TEXT        NOT NULL,
+    created_at    DATETIME    NOT NULL,
+    dispatched_at DATETIME    NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_outbox_events_dispatched_at ON outbox_events (dispatched_at);
+
+CREATE TABLE IF NOT EXISTS webhook_deliveries (
+    id              INTEGER     NOT NULL PRIMARY KEY AUTOINCREMENT,
+    event_id        INTEGER     NOT NULL,
+    endpoint_id     INTEGER     NOT NULL,
+    status          VARCHAR(16) NOT NULL,
+    attempts        INTEGER     NOT NULL DEFAULT 0,
+    response_status INTEGER     NULL,
+    error_message   TEXT        NULL,
+    next_attempt_at DATETIME    NULL,
+    created_at      DATETIME    NOT NULL,
+    delivered_at    DATETIME    NULL,
+    UNIQUE (event_id, endpoint_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_webhook_deliveries_status_next_attempt_at ON webhook_deliveries (status, next_attempt_at);
@@ -27,7 +27,7 @@ const docTemplate = `{
     "paths": {
         "/patient/details/": {
             "get": {
-                "description": "2020/05/09から前日までの指定都道府県の感染者数情報を取得する",
+                "description": "2020/05/09から前日までの指定都道府県の感染者数情報を取得する。calendar に日付ごとの曜日(weekday)と祝日かどうか(is_holiday)、period に解決した検索期間、statistics に記述統計(最小値・最大値とその日付、中央値、標準偏差、パーセンタイル、データがある日数と無い日数)を返す。metrics を指定すると日付ごとの派生指標を返す。anomalies に報告の偏りなどによる異常値(日付、値、予測値、ロバストzスコア、spike または drop)を返す",
                 "consumes": [
                     "application/json"
                 ],
@@ -39,20 +39,399 @@ const docTemplate = `{
                 ],
                 "summary": "感染者数詳細リスト取得",
                 "parameters": [
+                    {
+                        "type": "string",
+                        "example": "20230101",
+                        "description": "開始日(yyyymmdd, yyyy-mm-dd, latest, 最新のデータの日付からの相対指定 -30d, -4w)",
+                        "name": "start_date",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "latest",
+                        "description": "終了日(start_date と同じ形式)",
+                        "name": "end_date",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "last_4_weeks",
+                        "description": "期間(start_date, end_date の代わりに指定する。latest, last_30_days, last_4_weeks, this_week, this_month, 2022-Q3, 2022-09, 2022, 2023-01-01/2023-01-31)",
+                        "name": "period",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "day_over_day,week_over_week",
+                        "description": "カンマ区切りの派生指標(day_over_day, day_over_day_percent, week_over_week, doubling_time, halving_time, all)",
+                        "name": "metrics",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "\"北海道\"",
+                        "description": "都道府県名",
+                        "name": "area",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "\"en\"",
+                        "description": "エラーメッセージの言語(ja, en)",
+                        "name": "lang",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    },
+                    "503": {
+                        "description": "Service Unavailable"
+                    }
+                }
+            }
+        },
+        "/patient/rt": {
+            "get": {
+                "description": "指定都道府県の日ごとの感染者数から Cori の方法で実効再生産数(Rt)を推定する。date までの window 日間の事後分布の平均、中央値と信用区間を返す。期間の指定は感染者数詳細リスト取得と同じ",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Patients"
+                ],
+                "summary": "実効再生産数取得",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "\"東京都\"",
+                        "description": "都道府県名",
+                        "name": "area",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "-90d",
+                        "description": "開始日(yyyymmdd, yyyy-mm-dd, latest, -30d)",
+                        "name": "start_date",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "latest",
+                        "description": "終了日(start_date と同じ形式)",
+                        "name": "end_date",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "last_4_weeks",
+                        "description": "期間(start_date, end_date の代わりに指定する。last_4_weeks, 2022-Q3 など)",
+                        "name": "period",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "example": 4.7,
+                        "description": "発症間隔の平均(日)。既定値は4.7",
+                        "name": "si_mean",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "example": 2.9,
+                        "description": "発症間隔の標準偏差(日)。既定値は2.9",
+                        "name": "si_sd",
+                        "in": "query"
+                    },
                     {
                         "type": "integer",
-                        "example": 20230101,
-                        "description": "開始日",
+                        "example": 7,
+                        "description": "推定に使う日数(1〜28)。既定値は7",
+                        "name": "window",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "example": 0.95,
+                        "description": "信用区間の水準。既定値は0.95",
+                        "name": "credible_level",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "\"en\"",
+                        "description": "エラーメッセージの言語(ja, en)",
+                        "name": "lang",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    },
+                    "503": {
+                        "description": "Service Unavailable"
+                    }
+                }
+            }
+        },
+        "/patient/waves": {
+            "get": {
+                "description": "指定都道府県の日ごとの感染者数を移動平均で平滑化し、ピークと谷から流行の波(第N波)を検出する。波ごとに開始日、ピークの日と値、終了日、感染者数の合計を返す。期間を指定しなければ全ての期間から検出する",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Patients"
+                ],
+                "summary": "流行の波の取得",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "\"東京都\"",
+                        "description": "都道府県名",
+                        "name": "area",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "20200509",
+                        "description": "開始日(yyyymmdd, yyyy-mm-dd, latest, -30d)",
                         "name": "start_date",
                         "in": "query"
                     },
+                    {
+                        "type": "string",
+                        "example": "latest",
+                        "description": "終了日(start_date と同じ形式)",
+                        "name": "end_date",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "2022",
+                        "description": "期間(start_date, end_date の代わりに指定する。2022, 2022-Q3 など)",
+                        "name": "period",
+                        "in": "query"
+                    },
                     {
                         "type": "integer",
-                        "example": 20230102,
-                        "description": "終了日",
+                        "example": 7,
+                        "description": "平滑化(中心移動平均)の日数(1〜28)。既定値は7",
+                        "name": "smoothing_window",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "example": 0.5,
+                        "description": "ピークの高さに対するプロミネンスの割合の下限(0〜1)。既定値は0.5",
+                        "name": "min_prominence",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "example": 30,
+                        "description": "ピークの間隔の下限(0〜365日)。既定値は30",
+                        "name": "min_distance",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "example": 1,
+                        "description": "平滑化したピークの値の下限。既定値は1",
+                        "name": "min_peak",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "\"en\"",
+                        "description": "エラーメッセージの言語(ja, en)",
+                        "name": "lang",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    },
+                    "503": {
+                        "description": "Service Unavailable"
+                    }
+                }
+            }
+        },
+        "/patient/forecast": {
+            "get": {
+                "description": "指定都道府県の日ごとの感染者数を end_date までの window 日間から予測する。予測値(中央値)と予測区間を返す。model は log_linear(対数の線形トレンドと曜日の効果)か holt_winters(週の季節性がある Holt-Winters)。backtest を指定すると、過去に1週間ずつ遡った起点で予測した誤差(MAE, RMSE, MAPE, MASE)と予測区間に入った割合を返す",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Patients"
+                ],
+                "summary": "感染者数の予測",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "\"東京都\"",
+                        "description": "都道府県名",
+                        "name": "area",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "latest",
+                        "description": "学習に使う最後の日(yyyymmdd, yyyy-mm-dd, latest, -30d)。既定値は latest。その日のデータが無い場合は404",
                         "name": "end_date",
                         "in": "query"
                     },
+                    {
+                        "type": "string",
+                        "example": "holt_winters",
+                        "description": "予測モデル(log_linear, holt_winters)。既定値は holt_winters",
+                        "name": "model",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "example": 14,
+                        "description": "予測する日数(1〜14)。既定値は14",
+                        "name": "horizon",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "example": 42,
+                        "description": "学習に使う日数(21〜365)。既定値は42",
+                        "name": "window",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "example": 0.95,
+                        "description": "予測区間の水準。既定値は0.95",
+                        "name": "level",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "example": 8,
+                        "description": "検証する予測の起点の数(0〜52)。既定値は0(検証しない)。遡れるデータが足りない場合は400",
+                        "name": "backtest",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "\"en\"",
+                        "description": "エラーメッセージの言語(ja, en)",
+                        "name": "lang",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    },
+                    "503": {
+                        "description": "Service Unavailable"
+                    }
+                }
+            }
+        },
+        "/status": {
+            "get": {
+                "description": "最後に成功した取り込みと都道府県ごとの最新データの日付を取得する。anomalies に最新の14日間の全ての都道府県の異常値を返す",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Status"
+                ],
+                "summary": "データ取り込み状況取得",
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    },
+                    "503": {
+                        "description": "Service Unavailable"
+                    }
+                }
+            }
+        },
+        "/alert/subscriptions": {
+            "get": {
+                "description": "登録されているアラートの購読を取得する",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Alerts"
+                ],
+                "summary": "アラート購読一覧取得",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "管理用APIのキー",
+                        "name": "X-Api-Key",
+                        "in": "header",
+                        "required": true
+                    },
                     {
                         "type": "string",
                         "example": "\"北海道\"",
@@ -65,14 +444,421 @@ const docTemplate = `{
                     "200": {
                         "description": "OK"
                     },
+                    "401": {
+                        "description": "Unauthorized"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    },
+                    "503": {
+                        "description": "Service Unavailable"
+                    }
+                }
+            },
+            "post": {
+                "description": "都道府県の7日間平均が条件を満たしたときに Webhook へ署名付きで配信する購読を登録する。署名鍵はこのレスポンスでだけ返す",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Alerts"
+                ],
+                "summary": "アラート購読登録",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "管理用APIのキー",
+                        "name": "X-Api-Key",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "購読の条件(metric: week_over_week, level)",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/service.CreateAlertSubscriptionRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created"
+                    },
                     "400": {
                         "description": "Bad Request"
                     },
+                    "401": {
+                        "description": "Unauthorized"
+                    },
                     "500": {
                         "description": "Internal Server Error"
+                    },
+                    "503": {
+                        "description": "Service Unavailable"
                     }
                 }
             }
+        },
+        "/alert/subscriptions/{id}": {
+            "delete": {
+                "description": "アラートの購読を削除する。配信履歴は残す",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Alerts"
+                ],
+                "summary": "アラート購読削除",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "管理用APIのキー",
+                        "name": "X-Api-Key",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "example": 1,
+                        "description": "購読ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "description": "Unauthorized"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    },
+                    "503": {
+                        "description": "Service Unavailable"
+                    }
+                }
+            }
+        },
+        "/alert/subscriptions/{id}/deliveries": {
+            "get": {
+                "description": "アラートの購読の配信履歴を新しい順に100件取得する",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Alerts"
+                ],
+                "summary": "アラート配信履歴取得",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "管理用APIのキー",
+                        "name": "X-Api-Key",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "example": 1,
+                        "description": "購読ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "description": "Unauthorized"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    },
+                    "503": {
+                        "description": "Service Unavailable"
+                    }
+                }
+            }
+        },
+        "/webhooks": {
+            "get": {
+                "description": "登録されている Webhook を取得する",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Webhooks"
+                ],
+                "summary": "Webhook一覧取得",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "管理用APIのキー",
+                        "name": "X-Api-Key",
+                        "in": "header",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "401": {
+                        "description": "Unauthorized"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    },
+                    "503": {
+                        "description": "Service Unavailable"
+                    }
+                }
+            },
+            "post": {
+                "description": "patient_details の更新イベント(patient_details.updated)を配信する Webhook を登録する。署名鍵はこのレスポンスでだけ返す",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Webhooks"
+                ],
+                "summary": "Webhook登録",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "管理用APIのキー",
+                        "name": "X-Api-Key",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "配信先",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/service.CreateWebhookRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created"
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "description": "Unauthorized"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    },
+                    "503": {
+                        "description": "Service Unavailable"
+                    }
+                }
+            }
+        },
+        "/webhooks/dead-letters": {
+            "get": {
+                "description": "再送の上限に達した配信を新しい順に100件取得する",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Webhooks"
+                ],
+                "summary": "デッドレター一覧取得",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "管理用APIのキー",
+                        "name": "X-Api-Key",
+                        "in": "header",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "401": {
+                        "description": "Unauthorized"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    },
+                    "503": {
+                        "description": "Service Unavailable"
+                    }
+                }
+            }
+        },
+        "/webhooks/dead-letters/{id}/replay": {
+            "post": {
+                "description": "デッドレターを再送の対象に戻す。次回の配信(5分ごと)で送る",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Webhooks"
+                ],
+                "summary": "デッドレター再送",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "管理用APIのキー",
+                        "name": "X-Api-Key",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "example": 1,
+                        "description": "配信ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "description": "Unauthorized"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    },
+                    "503": {
+                        "description": "Service Unavailable"
+                    }
+                }
+            }
+        },
+        "/webhooks/{id}": {
+            "delete": {
+                "description": "Webhook を削除する。未配信の配信はデッドレターになる",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Webhooks"
+                ],
+                "summary": "Webhook削除",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "管理用APIのキー",
+                        "name": "X-Api-Key",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "example": 1,
+                        "description": "WebhookID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "description": "Unauthorized"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    },
+                    "503": {
+                        "description": "Service Unavailable"
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "service.CreateAlertSubscriptionRequest": {
+            "type": "object",
+            "properties": {
+                "area": {
+                    "type": "string"
+                },
+                "metric": {
+                    "type": "string"
+                },
+                "threshold": {
+                    "type": "number"
+                },
+                "webhook_url": {
+                    "type": "string"
+                }
+            }
+        },
+        "service.CreateWebhookRequest": {
+            "type": "object",
+            "properties": {
+                "url": {
+                    "type": "string"
+                }
+            }
         }
     }
 }`
@@ -0,0 +1,11 @@
+package main
+
+import (
+	"corona-api/src/adapter"
+	"corona-api/src/handlers"
+	"github.com/aws/aws-lambda-go/lambda"
+)
+
+func main() {
+	lambda.Start(adapter.Lambda(handlers.DeleteAlertSubscription))
+}
@@ -0,0 +1,10 @@
+package main
+
+import (
+	"corona-api/src/handlers"
+	"github.com/aws/aws-lambda-go/lambda"
+)
+
+func main() {
+	lambda.Start(handlers.DeliverAlertSubscriptions)
+}
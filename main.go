@@ -2,9 +2,18 @@ package main
 
 import (
 	docs "corona-api/docs"
+	"corona-api/src/adapter"
+	"corona-api/src/handlers"
 	"github.com/gin-gonic/gin"
+	"github.com/joho/godotenv"
 	swaggerfiles "github.com/swaggo/files"
 	ginSwagger "github.com/swaggo/gin-swagger"
+	"log"
+	"os"
+)
+
+const (
+	LocalEnvFile = "environments/local.env"
 )
 
 // @title Swagger Example API
@@ -18,8 +27,36 @@ import (
 // @license.url http://www.apache.org/licenses/LICENSE-2.0.html
 // @host localhost:8081
 func main() {
+	// ローカル環境の環境変数を読み込む
+	if os.Getenv("GO_ENV") == "local" {
+		if err := godotenv.Load(LocalEnvFile); err != nil {
+			log.Fatal(err)
+		}
+	}
+
+	r := newRouter()
+	r.Run(":8081")
+}
+
+func newRouter() *gin.Engine {
 	r := gin.Default()
 	docs.SwaggerInfo.BasePath = ""
 	r.GET("/swagger/*any", ginSwagger.WrapHandler(swaggerfiles.Handler))
-	r.Run(":8081")
+
+	// Lambdaハンドラをルートとして登録
+	r.GET("/patient/details/", adapter.Gin(handlers.GetPatientDetails))
+	r.GET("/patient/rt", adapter.Gin(handlers.GetPatientRt))
+	r.GET("/patient/waves", adapter.Gin(handlers.GetPatientWaves))
+	r.GET("/patient/forecast", adapter.Gin(handlers.GetPatientForecast))
+	r.GET("/status", adapter.Gin(handlers.GetStatus))
+	r.POST("/alert/subscriptions", adapter.Gin(handlers.CreateAlertSubscription))
+	r.GET("/alert/subscriptions", adapter.Gin(handlers.ListAlertSubscriptions))
+	r.DELETE("/alert/subscriptions/:id", adapter.Gin(handlers.DeleteAlertSubscription))
+	r.GET("/alert/subscriptions/:id/deliveries", adapter.Gin(handlers.ListAlertSubscriptionDeliveries))
+	r.POST("/webhooks", adapter.Gin(handlers.CreateWebhook))
+	r.GET("/webhooks", adapter.Gin(handlers.ListWebhooks))
+	r.DELETE("/webhooks/:id", adapter.Gin(handlers.DeleteWebhook))
+	r.GET("/webhooks/dead-letters", adapter.Gin(handlers.ListDeadLetters))
+	r.POST("/webhooks/dead-letters/:id/replay", adapter.Gin(handlers.ReplayDeadLetter))
+	return r
 }
@@ -1 +1,313 @@
 package main
+
+import (
+	"corona-api/src/handlers"
+	"corona-api/src/modules/date"
+	"encoding/json"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestPatientDetailsRouteValidationError(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	r := newRouter()
+
+	req := httptest.NewRequest(http.MethodGet, "/patient/details/?area=%E5%8C%97%E6%B5%B7%E9%81%93&start_date=abc", nil)
+	req.Header.Set("Accept-Language", "en")
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Equal(t, "en", w.Header().Get("Content-Language"))
+
+	var body map[string]interface{}
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+	assert.Equal(t, "VALIDATION_ERROR", body["errorCode"])
+	assert.Len(t, body["details"], 2)
+}
+
+func TestPatientDetailsRoutePeriodOutOfRange(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	r := newRouter()
+
+	// 今日を2023-01-10(JST)に固定する
+	clock := handlers.Clock
+	defer func() { handlers.Clock = clock }()
+	handlers.Clock = date.FixedClock{Time: time.Date(2023, 1, 10, 9, 0, 0, 0, time.FixedZone("JST", 9*60*60))}
+
+	// 開始日は集計の開始日より前、終了日は今日
+	req := httptest.NewRequest(http.MethodGet, "/patient/details/?area=%E5%8C%97%E6%B5%B7%E9%81%93&start_date=20200508&end_date=2023-01-10", nil)
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+
+	var body map[string]interface{}
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+	var fields []string
+	for _, detail := range body["details"].([]interface{}) {
+		assert.Equal(t, "out_of_range", detail.(map[string]interface{})["reason"])
+		fields = append(fields, detail.(map[string]interface{})["field"].(string))
+	}
+	assert.Equal(t, []string{"start_date", "end_date"}, fields)
+}
+
+func TestPatientDetailsRoutePeriodValidationError(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	r := newRouter()
+
+	tests := []struct {
+		query  string
+		reason string
+	}{
+		{query: "period=last_4_weeks&start_date=-30d", reason: "conflict"},
+		{query: "period=2022-Q5", reason: "invalid_format"},
+	}
+	for _, tt := range tests {
+		req := httptest.NewRequest(http.MethodGet, "/patient/details/?area=%E5%8C%97%E6%B5%B7%E9%81%93&"+tt.query, nil)
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code, tt.query)
+
+		var body map[string]interface{}
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+		details := body["details"].([]interface{})
+		assert.Len(t, details, 1, tt.query)
+		assert.Equal(t, "period", details[0].(map[string]interface{})["field"])
+		assert.Equal(t, tt.reason, details[0].(map[string]interface{})["reason"])
+	}
+}
+
+func TestPatientRtRouteValidationError(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	r := newRouter()
+
+	req := httptest.NewRequest(http.MethodGet, "/patient/rt?area=%E6%9D%B1%E4%BA%AC%E9%83%BD&period=last_4_weeks&si_mean=abc&window=0&credible_level=1", nil)
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+
+	var body map[string]interface{}
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+	var fields []string
+	for _, detail := range body["details"].([]interface{}) {
+		fields = append(fields, detail.(map[string]interface{})["field"].(string))
+	}
+	assert.Equal(t, []string{"si_mean", "credible_level", "window"}, fields)
+}
+
+func TestPatientWavesRouteValidationError(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	r := newRouter()
+
+	// 期間を指定しなくても都道府県名は必須
+	req := httptest.NewRequest(http.MethodGet, "/patient/waves?smoothing_window=0&min_prominence=1.5&min_distance=abc", nil)
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+
+	var body map[string]interface{}
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+	var fields []string
+	for _, detail := range body["details"].([]interface{}) {
+		fields = append(fields, detail.(map[string]interface{})["field"].(string))
+	}
+	assert.Equal(t, []string{"area", "smoothing_window", "min_prominence", "min_distance"}, fields)
+}
+
+func TestPatientForecastRouteValidationError(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	r := newRouter()
+
+	req := httptest.NewRequest(http.MethodGet, "/patient/forecast?area=%E6%9D%B1%E4%BA%AC%E9%83%BD&model=arima&horizon=28&window=7&backtest=-1", nil)
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+
+	var body map[string]interface{}
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+	var fields []string
+	for _, detail := range body["details"].([]interface{}) {
+		fields = append(fields, detail.(map[string]interface{})["field"].(string))
+	}
+	assert.Equal(t, []string{"model", "horizon", "window", "backtest"}, fields)
+}
+
+func TestSwaggerRoute(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	r := newRouter()
+
+	req := httptest.NewRequest(http.MethodGet, "/swagger/doc.json", nil)
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), "/patient/details/")
+}
+
+// testManagementAPIKey はテストで設定する管理用APIのキー
+const testManagementAPIKey = "test-management-api-key"
+
+// setEnv は環境変数を設定し、テストの終了後に元に戻す
+func setEnv(t *testing.T, key, value string) {
+	prev, ok := os.LookupEnv(key)
+	assert.NoError(t, os.Setenv(key, value))
+	t.Cleanup(func() {
+		if ok {
+			os.Setenv(key, prev)
+		} else {
+			os.Unsetenv(key)
+		}
+	})
+}
+
+// setManagementAPIKey はローカル環境として管理用APIのキーを設定する
+func setManagementAPIKey(t *testing.T, key string) {
+	setEnv(t, "GO_ENV", "local")
+	setEnv(t, "MANAGEMENT_API_KEY", key)
+}
+
+// managementRoute は管理用APIのルート
+type managementRoute struct {
+	method string
+	path   string
+	body   string
+}
+
+// assertUnauthorized はAPIキーが無いか一致しない場合、キーを設定していない場合に 401 を返すことを検証する
+func assertUnauthorized(t *testing.T, routes []managementRoute) {
+	gin.SetMode(gin.TestMode)
+	r := newRouter()
+
+	tests := []struct {
+		name       string
+		configured string
+		header     string
+	}{
+		{"no key", testManagementAPIKey, ""},
+		{"wrong key", testManagementAPIKey, "wrong-key"},
+		// キーを設定していない環境では空のキーも含めて全て拒否する
+		{"not configured", "", ""},
+		{"not configured with key", "", "any-key"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			setManagementAPIKey(t, tt.configured)
+			for _, route := range routes {
+				req := httptest.NewRequest(route.method, route.path, strings.NewReader(route.body))
+				if tt.header != "" {
+					req.Header.Set("X-Api-Key", tt.header)
+				}
+				w := httptest.NewRecorder()
+				r.ServeHTTP(w, req)
+
+				assert.Equal(t, http.StatusUnauthorized, w.Code, route.path)
+				assert.Contains(t, w.Body.String(), `"errorCode":"UNAUTHORIZED"`, route.path)
+			}
+		})
+	}
+}
+
+func TestAlertSubscriptionRoutesUnauthorized(t *testing.T) {
+	assertUnauthorized(t, []managementRoute{
+		{http.MethodPost, "/alert/subscriptions", `{"area":"東京都","metric":"level","threshold":1000,"webhook_url":"https://example.com/hooks"}`},
+		{http.MethodGet, "/alert/subscriptions", ""},
+		{http.MethodDelete, "/alert/subscriptions/1", ""},
+		{http.MethodGet, "/alert/subscriptions/1/deliveries", ""},
+	})
+}
+
+func TestCreateAlertSubscriptionRouteValidationError(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	r := newRouter()
+	setManagementAPIKey(t, testManagementAPIKey)
+
+	req := httptest.NewRequest(http.MethodPost, "/alert/subscriptions", strings.NewReader(`{"area":"東京都","metric":"unknown","webhook_url":"ftp://example.com"}`))
+	req.Header.Set("X-Api-Key", testManagementAPIKey)
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+
+	var body map[string]interface{}
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+	assert.Equal(t, "VALIDATION_ERROR", body["errorCode"])
+	var fields []string
+	for _, detail := range body["details"].([]interface{}) {
+		fields = append(fields, detail.(map[string]interface{})["field"].(string))
+	}
+	assert.Equal(t, []string{"metric", "threshold", "webhook_url"}, fields)
+}
+
+func TestDeleteAlertSubscriptionRouteInvalidID(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	r := newRouter()
+	setManagementAPIKey(t, testManagementAPIKey)
+
+	req := httptest.NewRequest(http.MethodDelete, "/alert/subscriptions/abc", nil)
+	req.Header.Set("X-Api-Key", testManagementAPIKey)
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestWebhookRoutesUnauthorized(t *testing.T) {
+	assertUnauthorized(t, []managementRoute{
+		{http.MethodPost, "/webhooks", `{"url":"https://example.com/hooks"}`},
+		{http.MethodGet, "/webhooks", ""},
+		{http.MethodDelete, "/webhooks/1", ""},
+		{http.MethodGet, "/webhooks/dead-letters", ""},
+		{http.MethodPost, "/webhooks/dead-letters/1/replay", ""},
+	})
+}
+
+func TestCreateWebhookRouteValidationError(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	r := newRouter()
+	setManagementAPIKey(t, testManagementAPIKey)
+
+	tests := []struct {
+		url    string
+		reason string
+	}{
+		{"example.com", "invalid_format"},
+		{"http://93.184.216.34/hooks", "invalid_format"},
+		// 内部のネットワークやメタデータのアドレスは登録できない
+		{"https://127.0.0.1/hooks", "not_allowed"},
+		{"https://169.254.169.254/latest/meta-data/", "not_allowed"},
+		{"https://[fd00:ec2::254]/", "not_allowed"},
+	}
+	for _, tt := range tests {
+		req := httptest.NewRequest(http.MethodPost, "/webhooks", strings.NewReader(`{"url":"`+tt.url+`"}`))
+		req.Header.Set("X-Api-Key", testManagementAPIKey)
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code, tt.url)
+		assert.Contains(t, w.Body.String(), `"field":"url","reason":"`+tt.reason+`"`, tt.url)
+	}
+}
+
+func TestReplayDeadLetterRouteInvalidID(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	r := newRouter()
+	setManagementAPIKey(t, testManagementAPIKey)
+
+	req := httptest.NewRequest(http.MethodPost, "/webhooks/dead-letters/0/replay", nil)
+	req.Header.Set("X-Api-Key", testManagementAPIKey)
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
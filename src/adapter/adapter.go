@@ -0,0 +1,102 @@
+package adapter
+
+import (
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"unicode/utf8"
+)
+
+func decodeBody(body string, isBase64Encoded bool) ([]byte, error) {
+	if !isBase64Encoded {
+		return []byte(body), nil
+	}
+	decoded, err := base64.StdEncoding.DecodeString(body)
+	if err != nil {
+		return nil, fmt.Errorf("base64 decode error: %v", err)
+	}
+	return decoded, nil
+}
+
+// encodeBody はUTF-8として扱えないボディをbase64にエンコードする
+func encodeBody(body []byte) (string, bool) {
+	if utf8.Valid(body) {
+		return string(body), false
+	}
+	return base64.StdEncoding.EncodeToString(body), true
+}
+
+func newHeader(single map[string]string, multi map[string][]string) http.Header {
+	header := http.Header{}
+	for key, values := range multi {
+		for _, value := range values {
+			header.Add(key, value)
+		}
+	}
+	for key, value := range single {
+		if _, ok := header[http.CanonicalHeaderKey(key)]; !ok {
+			header.Set(key, value)
+		}
+	}
+	return header
+}
+
+func newQuery(single map[string]string, multi map[string][]string) url.Values {
+	query := url.Values{}
+	for key, values := range multi {
+		query[key] = append(query[key], values...)
+	}
+	for key, value := range single {
+		if _, ok := query[key]; !ok {
+			query.Set(key, value)
+		}
+	}
+	return query
+}
+
+// newQueryFromRawQueryString はHTTP APIのペイロード(v2)のクエリ文字列を解析する
+// queryStringParameters は複数の値がカンマで連結されるため rawQueryString を優先する
+func newQueryFromRawQueryString(rawQueryString string, single map[string]string) url.Values {
+	if rawQueryString != "" {
+		query, err := url.ParseQuery(rawQueryString)
+		if err == nil {
+			return query
+		}
+	}
+	return newQuery(single, nil)
+}
+
+func singleValueHeaders(header http.Header) map[string]string {
+	headers := map[string]string{}
+	for key, values := range header {
+		headers[key] = strings.Join(values, ",")
+	}
+	return headers
+}
+
+func multiValueHeaders(header http.Header) map[string][]string {
+	headers := map[string][]string{}
+	for key, values := range header {
+		headers[key] = values
+	}
+	return headers
+}
+
+func cookieHeader(header http.Header, cookies []string) {
+	if len(cookies) > 0 {
+		header.Set("Cookie", strings.Join(cookies, "; "))
+	}
+}
+
+// setCookies はv2形式のレスポンス用にSet-Cookieヘッダを取り出す
+func setCookies(header http.Header) (http.Header, []string) {
+	cookies := header.Values("Set-Cookie")
+	if len(cookies) == 0 {
+		return header, nil
+	}
+	header = header.Clone()
+	header.Del("Set-Cookie")
+	return header, cookies
+}
@@ -0,0 +1,104 @@
+package adapter
+
+import (
+	"context"
+	"corona-api/src/transport"
+	"encoding/json"
+	"github.com/aws/aws-lambda-go/events"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// echoHandler は受け取ったリクエストの内容をそのまま返す
+func echoHandler(ctx context.Context, request transport.Request) (transport.Response, error) {
+	return transport.JSONResponse(http.StatusOK, map[string]interface{}{
+		"method": request.Method,
+		"path":   request.Path,
+		"area":   request.Query["area"],
+		"lang":   request.Headers.Get("Accept-Language"),
+		"body":   string(request.Body),
+	})
+}
+
+func assertEcho(t *testing.T, body string) {
+	var got map[string]interface{}
+	assert.NoError(t, json.Unmarshal([]byte(body), &got))
+	assert.Equal(t, "GET", got["method"])
+	assert.Equal(t, "/patient/details/", got["path"])
+	assert.Equal(t, []interface{}{"北海道", "東京都"}, got["area"])
+	assert.Equal(t, "en", got["lang"])
+}
+
+func TestAPIGatewayProxy(t *testing.T) {
+	res, err := APIGatewayProxy(echoHandler)(context.Background(), events.APIGatewayProxyRequest{
+		HTTPMethod:                      "GET",
+		Path:                            "/patient/details/",
+		Headers:                         map[string]string{"accept-language": "en"},
+		MultiValueQueryStringParameters: map[string][]string{"area": {"北海道", "東京都"}},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+	assert.Equal(t, []string{"application/json"}, res.MultiValueHeaders["Content-Type"])
+	assertEcho(t, res.Body)
+}
+
+func TestAPIGatewayV2HTTP(t *testing.T) {
+	request := events.APIGatewayV2HTTPRequest{
+		RawPath:        "/patient/details/",
+		RawQueryString: "area=%E5%8C%97%E6%B5%B7%E9%81%93&area=%E6%9D%B1%E4%BA%AC%E9%83%BD",
+		Headers:        map[string]string{"accept-language": "en"},
+	}
+	request.RequestContext.HTTP.Method = "GET"
+	res, err := APIGatewayV2HTTP(echoHandler)(context.Background(), request)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+	assertEcho(t, res.Body)
+}
+
+func TestLambdaFunctionURL(t *testing.T) {
+	request := events.LambdaFunctionURLRequest{
+		RawPath:               "/patient/details/",
+		QueryStringParameters: map[string]string{"area": "北海道"},
+		RawQueryString:        "area=%E5%8C%97%E6%B5%B7%E9%81%93&area=%E6%9D%B1%E4%BA%AC%E9%83%BD",
+		Headers:               map[string]string{"accept-language": "en"},
+	}
+	request.RequestContext.HTTP.Method = "GET"
+	res, err := LambdaFunctionURL(echoHandler)(context.Background(), request)
+	assert.NoError(t, err)
+	assert.Equal(t, "application/json", res.Headers["Content-Type"])
+	assertEcho(t, res.Body)
+}
+
+func TestALBTargetGroup(t *testing.T) {
+	res, err := ALBTargetGroup(echoHandler)(context.Background(), events.ALBTargetGroupRequest{
+		HTTPMethod:                      "GET",
+		Path:                            "/patient/details/",
+		MultiValueQueryStringParameters: map[string][]string{"area": {"%E5%8C%97%E6%B5%B7%E9%81%93", "%E6%9D%B1%E4%BA%AC%E9%83%BD"}},
+		MultiValueHeaders:               map[string][]string{"accept-language": {"en"}},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "200 OK", res.StatusDescription)
+	assert.Equal(t, []string{"application/json"}, res.MultiValueHeaders["Content-Type"])
+	assertEcho(t, res.Body)
+}
+
+func TestHTTP(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/patient/details/?area=%E5%8C%97%E6%B5%B7%E9%81%93&area=%E6%9D%B1%E4%BA%AC%E9%83%BD", nil)
+	req.Header.Set("Accept-Language", "en")
+	w := httptest.NewRecorder()
+	HTTP(echoHandler).ServeHTTP(w, req)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assertEcho(t, w.Body.String())
+}
+
+func TestBinaryBody(t *testing.T) {
+	binary := func(ctx context.Context, request transport.Request) (transport.Response, error) {
+		return transport.Response{StatusCode: http.StatusOK, Body: []byte{0xff, 0xfe}}, nil
+	}
+	res, err := APIGatewayProxy(binary)(context.Background(), events.APIGatewayProxyRequest{})
+	assert.NoError(t, err)
+	assert.True(t, res.IsBase64Encoded)
+	assert.Equal(t, "//4=", res.Body)
+}
@@ -0,0 +1,64 @@
+package adapter
+
+import (
+	"context"
+	"corona-api/src/transport"
+	"fmt"
+	"github.com/aws/aws-lambda-go/events"
+	"net/http"
+	"net/url"
+)
+
+// ALBTargetGroup はハンドラをALBのターゲットグループ用のLambdaハンドラに変換する
+func ALBTargetGroup(h transport.Handler) func(ctx context.Context, request events.ALBTargetGroupRequest) (events.ALBTargetGroupResponse, error) {
+	return func(ctx context.Context, request events.ALBTargetGroupRequest) (events.ALBTargetGroupResponse, error) {
+		body, err := decodeBody(request.Body, request.IsBase64Encoded)
+		if err != nil {
+			return events.ALBTargetGroupResponse{}, err
+		}
+
+		// ALBはクエリパラメータをURLエンコードしたまま渡す
+		query := url.Values{}
+		for key, values := range newQuery(request.QueryStringParameters, request.MultiValueQueryStringParameters) {
+			decodedKey, err := url.QueryUnescape(key)
+			if err != nil {
+				decodedKey = key
+			}
+			for _, value := range values {
+				decodedValue, err := url.QueryUnescape(value)
+				if err != nil {
+					decodedValue = value
+				}
+				query.Add(decodedKey, decodedValue)
+			}
+		}
+
+		header := newHeader(request.Headers, request.MultiValueHeaders)
+		res, err := h(ctx, transport.Request{
+			Method:   request.HTTPMethod,
+			Path:     request.Path,
+			Query:    query,
+			Headers:  header,
+			Body:     body,
+			SourceIP: header.Get("X-Forwarded-For"),
+		})
+		if err != nil {
+			return events.ALBTargetGroupResponse{}, err
+		}
+
+		responseBody, isBase64Encoded := encodeBody(res.Body)
+		response := events.ALBTargetGroupResponse{
+			StatusCode:        res.StatusCode,
+			StatusDescription: fmt.Sprintf("%d %s", res.StatusCode, http.StatusText(res.StatusCode)),
+			Body:              responseBody,
+			IsBase64Encoded:   isBase64Encoded,
+		}
+		// マルチバリューヘッダが有効なターゲットグループにはマルチバリューで返す
+		if request.MultiValueHeaders != nil {
+			response.MultiValueHeaders = multiValueHeaders(res.Headers)
+		} else {
+			response.Headers = singleValueHeaders(res.Headers)
+		}
+		return response, nil
+	}
+}
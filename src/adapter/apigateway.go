@@ -0,0 +1,39 @@
+package adapter
+
+import (
+	"context"
+	"corona-api/src/transport"
+	"github.com/aws/aws-lambda-go/events"
+)
+
+// APIGatewayProxy はハンドラをAPI Gateway REST API(ペイロード v1)用のLambdaハンドラに変換する
+func APIGatewayProxy(h transport.Handler) func(ctx context.Context, request events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error) {
+	return func(ctx context.Context, request events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error) {
+		body, err := decodeBody(request.Body, request.IsBase64Encoded)
+		if err != nil {
+			return events.APIGatewayProxyResponse{}, err
+		}
+
+		res, err := h(ctx, transport.Request{
+			Method:     request.HTTPMethod,
+			Path:       request.Path,
+			Query:      newQuery(request.QueryStringParameters, request.MultiValueQueryStringParameters),
+			Headers:    newHeader(request.Headers, request.MultiValueHeaders),
+			PathParams: request.PathParameters,
+			Body:       body,
+			SourceIP:   request.RequestContext.Identity.SourceIP,
+			RequestID:  request.RequestContext.RequestID,
+		})
+		if err != nil {
+			return events.APIGatewayProxyResponse{}, err
+		}
+
+		responseBody, isBase64Encoded := encodeBody(res.Body)
+		return events.APIGatewayProxyResponse{
+			StatusCode:        res.StatusCode,
+			MultiValueHeaders: multiValueHeaders(res.Headers),
+			Body:              responseBody,
+			IsBase64Encoded:   isBase64Encoded,
+		}, nil
+	}
+}
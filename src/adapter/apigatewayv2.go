@@ -0,0 +1,43 @@
+package adapter
+
+import (
+	"context"
+	"corona-api/src/transport"
+	"github.com/aws/aws-lambda-go/events"
+)
+
+// APIGatewayV2HTTP はハンドラをAPI Gateway HTTP API(ペイロード v2)用のLambdaハンドラに変換する
+func APIGatewayV2HTTP(h transport.Handler) func(ctx context.Context, request events.APIGatewayV2HTTPRequest) (events.APIGatewayV2HTTPResponse, error) {
+	return func(ctx context.Context, request events.APIGatewayV2HTTPRequest) (events.APIGatewayV2HTTPResponse, error) {
+		body, err := decodeBody(request.Body, request.IsBase64Encoded)
+		if err != nil {
+			return events.APIGatewayV2HTTPResponse{}, err
+		}
+
+		header := newHeader(request.Headers, nil)
+		cookieHeader(header, request.Cookies)
+		res, err := h(ctx, transport.Request{
+			Method:     request.RequestContext.HTTP.Method,
+			Path:       request.RawPath,
+			Query:      newQueryFromRawQueryString(request.RawQueryString, request.QueryStringParameters),
+			Headers:    header,
+			PathParams: request.PathParameters,
+			Body:       body,
+			SourceIP:   request.RequestContext.HTTP.SourceIP,
+			RequestID:  request.RequestContext.RequestID,
+		})
+		if err != nil {
+			return events.APIGatewayV2HTTPResponse{}, err
+		}
+
+		responseHeader, cookies := setCookies(res.Headers)
+		responseBody, isBase64Encoded := encodeBody(res.Body)
+		return events.APIGatewayV2HTTPResponse{
+			StatusCode:      res.StatusCode,
+			Headers:         singleValueHeaders(responseHeader),
+			Body:            responseBody,
+			IsBase64Encoded: isBase64Encoded,
+			Cookies:         cookies,
+		}, nil
+	}
+}
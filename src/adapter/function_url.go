@@ -0,0 +1,42 @@
+package adapter
+
+import (
+	"context"
+	"corona-api/src/transport"
+	"github.com/aws/aws-lambda-go/events"
+)
+
+// LambdaFunctionURL はハンドラをLambda関数URL用のLambdaハンドラに変換する
+func LambdaFunctionURL(h transport.Handler) func(ctx context.Context, request events.LambdaFunctionURLRequest) (events.LambdaFunctionURLResponse, error) {
+	return func(ctx context.Context, request events.LambdaFunctionURLRequest) (events.LambdaFunctionURLResponse, error) {
+		body, err := decodeBody(request.Body, request.IsBase64Encoded)
+		if err != nil {
+			return events.LambdaFunctionURLResponse{}, err
+		}
+
+		header := newHeader(request.Headers, nil)
+		cookieHeader(header, request.Cookies)
+		res, err := h(ctx, transport.Request{
+			Method:    request.RequestContext.HTTP.Method,
+			Path:      request.RawPath,
+			Query:     newQueryFromRawQueryString(request.RawQueryString, request.QueryStringParameters),
+			Headers:   header,
+			Body:      body,
+			SourceIP:  request.RequestContext.HTTP.SourceIP,
+			RequestID: request.RequestContext.RequestID,
+		})
+		if err != nil {
+			return events.LambdaFunctionURLResponse{}, err
+		}
+
+		responseHeader, cookies := setCookies(res.Headers)
+		responseBody, isBase64Encoded := encodeBody(res.Body)
+		return events.LambdaFunctionURLResponse{
+			StatusCode:      res.StatusCode,
+			Headers:         singleValueHeaders(responseHeader),
+			Body:            responseBody,
+			IsBase64Encoded: isBase64Encoded,
+			Cookies:         cookies,
+		}, nil
+	}
+}
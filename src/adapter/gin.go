@@ -0,0 +1,36 @@
+package adapter
+
+import (
+	"corona-api/src/transport"
+	"github.com/gin-gonic/gin"
+	"io"
+	"log"
+	"net/http"
+)
+
+// Gin はハンドラをGinのルートとして実行できるように変換する
+func Gin(h transport.Handler) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		body, err := io.ReadAll(c.Request.Body)
+		if err != nil {
+			log.Println(err)
+			c.JSON(http.StatusBadRequest, gin.H{"message": http.StatusText(http.StatusBadRequest)})
+			return
+		}
+
+		pathParams := map[string]string{}
+		for _, param := range c.Params {
+			pathParams[param.Key] = param.Value
+		}
+		request := newRequest(c.Request, body, pathParams)
+		request.SourceIP = c.ClientIP()
+
+		res, err := h(c.Request.Context(), request)
+		if err != nil {
+			log.Println(err)
+			c.JSON(http.StatusInternalServerError, gin.H{"message": http.StatusText(http.StatusInternalServerError)})
+			return
+		}
+		writeResponse(c.Writer, res)
+	}
+}
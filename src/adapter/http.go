@@ -0,0 +1,58 @@
+package adapter
+
+import (
+	"corona-api/src/transport"
+	"io"
+	"log"
+	"net"
+	"net/http"
+)
+
+// HTTP はハンドラを net/http のハンドラに変換する
+func HTTP(h transport.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			log.Println(err)
+			http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+			return
+		}
+
+		res, err := h(r.Context(), newRequest(r, body, nil))
+		if err != nil {
+			log.Println(err)
+			http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+			return
+		}
+		writeResponse(w, res)
+	})
+}
+
+func newRequest(r *http.Request, body []byte, pathParams map[string]string) transport.Request {
+	sourceIP, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		sourceIP = r.RemoteAddr
+	}
+	return transport.Request{
+		Method:     r.Method,
+		Path:       r.URL.Path,
+		Query:      r.URL.Query(),
+		Headers:    r.Header.Clone(),
+		PathParams: pathParams,
+		Body:       body,
+		SourceIP:   sourceIP,
+		RequestID:  r.Header.Get("X-Request-Id"),
+	}
+}
+
+func writeResponse(w http.ResponseWriter, res transport.Response) {
+	for key, values := range res.Headers {
+		for _, value := range values {
+			w.Header().Add(key, value)
+		}
+	}
+	w.WriteHeader(res.StatusCode)
+	if _, err := w.Write(res.Body); err != nil {
+		log.Println(err)
+	}
+}
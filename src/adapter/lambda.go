@@ -0,0 +1,29 @@
+package adapter
+
+import (
+	"corona-api/src/transport"
+	"os"
+)
+
+// 環境変数 API_EVENT_FORMAT に指定できるLambdaの呼び出し元
+const (
+	EventFormatAPIGatewayREST = "rest"
+	EventFormatAPIGatewayHTTP = "http"
+	EventFormatFunctionURL    = "url"
+	EventFormatALB            = "alb"
+)
+
+// Lambda は環境変数 API_EVENT_FORMAT に応じたLambdaハンドラを返す
+// 未指定の場合は API Gateway REST API として扱う
+func Lambda(h transport.Handler) interface{} {
+	switch os.Getenv("API_EVENT_FORMAT") {
+	case EventFormatAPIGatewayHTTP:
+		return APIGatewayV2HTTP(h)
+	case EventFormatFunctionURL:
+		return LambdaFunctionURL(h)
+	case EventFormatALB:
+		return ALBTargetGroup(h)
+	default:
+		return APIGatewayProxy(h)
+	}
+}
@@ -0,0 +1,116 @@
+package handlers
+
+import (
+	"context"
+	"corona-api/src/middleware"
+	"corona-api/src/modules/common"
+	"corona-api/src/service"
+	"corona-api/src/transport"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+var alertSubscriptionService = service.NewAlertSubscriptionService(middleware.ConnectDb)
+
+// @summary	アラート購読登録
+// @description 都道府県の7日間平均が条件を満たしたときに Webhook へ署名付きで配信する購読を登録する。署名鍵はこのレスポンスでだけ返す
+// @tags Alerts
+// @accept json
+// @produce json
+// @param X-Api-Key header string true "管理用APIのキー"
+// @param request body service.CreateAlertSubscriptionRequest true "購読の条件(metric: week_over_week, level)"
+// @Success 201
+// @failure 400
+// @failure 401
+// @failure 500
+// @failure 503
+// @router /alert/subscriptions [post]
+func CreateAlertSubscription(ctx context.Context, request transport.Request) (transport.Response, error) {
+	lang := request.Language()
+	if err := authorize(request); err != nil {
+		return transport.ErrorResponse(err, lang)
+	}
+
+	var body service.CreateAlertSubscriptionRequest
+	if err := json.Unmarshal(request.Body, &body); err != nil {
+		return transport.ErrorResponse(common.NewValidationError(fmt.Errorf("json.Unmarshal() error: %v", err), common.FieldError{Field: "body", Reason: common.FieldReasonInvalidFormat}), lang)
+	}
+
+	res, err := alertSubscriptionService.CreateSubscription(ctx, body)
+	if err != nil {
+		return transport.ErrorResponse(err, lang)
+	}
+	return transport.JSONResponse(http.StatusCreated, res)
+}
+
+// @summary	アラート購読一覧取得
+// @description 登録されているアラートの購読を取得する
+// @tags Alerts
+// @accept json
+// @produce json
+// @param X-Api-Key header string true "管理用APIのキー"
+// @param area query string false "都道府県名" example("北海道")
+// @Success 200
+// @failure 401
+// @failure 500
+// @failure 503
+// @router /alert/subscriptions [get]
+func ListAlertSubscriptions(ctx context.Context, request transport.Request) (transport.Response, error) {
+	if err := authorize(request); err != nil {
+		return transport.ErrorResponse(err, request.Language())
+	}
+	res, err := alertSubscriptionService.ListSubscriptions(ctx, request.Query.Get("area"))
+	if err != nil {
+		return transport.ErrorResponse(err, request.Language())
+	}
+	return transport.JSONResponse(http.StatusOK, res)
+}
+
+// @summary	アラート購読削除
+// @description アラートの購読を削除する。配信履歴は残す
+// @tags Alerts
+// @accept json
+// @produce json
+// @param X-Api-Key header string true "管理用APIのキー"
+// @param id path int true "購読ID" example(1)
+// @Success 204
+// @failure 400
+// @failure 401
+// @failure 404
+// @failure 500
+// @failure 503
+// @router /alert/subscriptions/{id} [delete]
+func DeleteAlertSubscription(ctx context.Context, request transport.Request) (transport.Response, error) {
+	if err := authorize(request); err != nil {
+		return transport.ErrorResponse(err, request.Language())
+	}
+	if err := alertSubscriptionService.DeleteSubscription(ctx, request.PathParams["id"]); err != nil {
+		return transport.ErrorResponse(err, request.Language())
+	}
+	return transport.Response{StatusCode: http.StatusNoContent, Headers: http.Header{}}, nil
+}
+
+// @summary	アラート配信履歴取得
+// @description アラートの購読の配信履歴を新しい順に100件取得する
+// @tags Alerts
+// @accept json
+// @produce json
+// @param X-Api-Key header string true "管理用APIのキー"
+// @param id path int true "購読ID" example(1)
+// @Success 200
+// @failure 400
+// @failure 401
+// @failure 500
+// @failure 503
+// @router /alert/subscriptions/{id}/deliveries [get]
+func ListAlertSubscriptionDeliveries(ctx context.Context, request transport.Request) (transport.Response, error) {
+	if err := authorize(request); err != nil {
+		return transport.ErrorResponse(err, request.Language())
+	}
+	res, err := alertSubscriptionService.ListDeliveries(ctx, request.PathParams["id"])
+	if err != nil {
+		return transport.ErrorResponse(err, request.Language())
+	}
+	return transport.JSONResponse(http.StatusOK, res)
+}
@@ -0,0 +1,31 @@
+package handlers
+
+import (
+	"corona-api/src/middleware"
+	"corona-api/src/modules/common"
+	"corona-api/src/transport"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+)
+
+// APIKeyHeader は管理用APIのキーを送るヘッダ(API Gateway の API キーと同じ)
+const APIKeyHeader = "X-Api-Key"
+
+// authorize は購読や Webhook を管理するリクエストのAPIキーを検証する
+// API Gateway 以外(ALB、関数URL、ローカルのサーバー)から呼ばれても外部へ送る宛先を登録させないため、
+// キーを設定していない場合も拒否する
+func authorize(request transport.Request) error {
+	key, err := middleware.LoadManagementAPIKey()
+	if err != nil {
+		return common.NewInternalError(fmt.Errorf("middleware.LoadManagementAPIKey() error: %v", err))
+	}
+	if key == "" {
+		return common.NewUnauthorizedError(errors.New("management api key is not configured"))
+	}
+	got := request.Headers.Get(APIKeyHeader)
+	if subtle.ConstantTimeCompare([]byte(got), []byte(key)) != 1 {
+		return common.NewUnauthorizedError(errors.New("invalid api key"))
+	}
+	return nil
+}
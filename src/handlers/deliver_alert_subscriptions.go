@@ -0,0 +1,27 @@
+package handlers
+
+import (
+	"context"
+	"corona-api/src/middleware"
+	"corona-api/src/modules/subscription"
+)
+
+type DeliverAlertSubscriptionsResponse struct {
+	Delivered int `json:"Delivered"`
+}
+
+// DeliverAlertSubscriptions は期限が来たアラートの配信(再送を含む)を送る
+func DeliverAlertSubscriptions(ctx context.Context) (DeliverAlertSubscriptionsResponse, error) {
+	// DB接続
+	db, err := middleware.ConnectDb()
+	if err != nil {
+		return DeliverAlertSubscriptionsResponse{}, err
+	}
+	defer db.Close()
+
+	delivered, err := subscription.NewDeliverer().DeliverDue(ctx, db)
+	if err != nil {
+		return DeliverAlertSubscriptionsResponse{Delivered: delivered}, err
+	}
+	return DeliverAlertSubscriptionsResponse{Delivered: delivered}, nil
+}
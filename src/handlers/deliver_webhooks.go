@@ -0,0 +1,36 @@
+package handlers
+
+import (
+	"context"
+	"corona-api/src/middleware"
+	"corona-api/src/modules/outbox"
+	"database/sql"
+)
+
+type DeliverWebhooksResponse struct {
+	Dispatched int `json:"Dispatched"`
+	Delivered  int `json:"Delivered"`
+}
+
+// DeliverWebhooks はアウトボックスのイベントを Webhook へ配信する(再送を含む)
+func DeliverWebhooks(ctx context.Context) (DeliverWebhooksResponse, error) {
+	// DB接続
+	db, err := middleware.ConnectDb()
+	if err != nil {
+		return DeliverWebhooksResponse{}, err
+	}
+	defer db.Close()
+
+	return deliverWebhooks(ctx, db)
+}
+
+func deliverWebhooks(ctx context.Context, db *sql.DB) (DeliverWebhooksResponse, error) {
+	var res DeliverWebhooksResponse
+	var err error
+	res.Dispatched, err = outbox.Dispatch(ctx, middleware.NewTxAdmin(db))
+	if err != nil {
+		return res, err
+	}
+	res.Delivered, err = outbox.NewDeliverer().DeliverDue(ctx, db)
+	return res, err
+}
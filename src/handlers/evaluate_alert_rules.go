@@ -0,0 +1,42 @@
+package handlers
+
+import (
+	"context"
+	"corona-api/src/middleware"
+	"corona-api/src/modules/alert"
+	"corona-api/src/modules/subscription"
+	"log"
+)
+
+type EvaluateAlertRulesResponse struct {
+	Count  int           `json:"Count"`
+	Alerts []alert.Alert `json:"Alerts"`
+}
+
+// EvaluateAlertRules は取り込み後のデータでアラートのルールと購読を評価し、新たに発火したルールのアラートを返す
+// 購読のアラートはこの中で各購読の Webhook へ配信する
+func EvaluateAlertRules(ctx context.Context) (EvaluateAlertRulesResponse, error) {
+	// DB接続
+	db, err := middleware.ConnectDb()
+	if err != nil {
+		return EvaluateAlertRulesResponse{}, err
+	}
+	defer db.Close()
+	txAdmin := middleware.NewTxAdmin(db)
+
+	alerts, err := alert.EvaluateLatest(ctx, txAdmin)
+	if err != nil {
+		return EvaluateAlertRulesResponse{}, err
+	}
+
+	// 購読の配信に失敗しても配信履歴から再送するので、ルールのアラートの通知は続ける
+	if _, err := subscription.EvaluateLatest(ctx, txAdmin); err != nil {
+		log.Println(err)
+	} else if _, err := subscription.NewDeliverer().DeliverDue(ctx, db); err != nil {
+		log.Println(err)
+	}
+	if alerts == nil {
+		alerts = []alert.Alert{}
+	}
+	return EvaluateAlertRulesResponse{Count: len(alerts), Alerts: alerts}, nil
+}
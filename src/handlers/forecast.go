@@ -0,0 +1,46 @@
+package handlers
+
+import (
+	"context"
+	"corona-api/src/middleware"
+	"corona-api/src/service"
+	"corona-api/src/transport"
+	"net/http"
+)
+
+var forecastService = service.NewForecastService(middleware.ConnectDb, currentClock{})
+
+// @summary	感染者数の予測
+// @description 指定都道府県の日ごとの感染者数を end_date までの window 日間から予測する。予測値(中央値)と予測区間を返す。model は log_linear(対数の線形トレンドと曜日の効果)か holt_winters(週の季節性がある Holt-Winters)。backtest を指定すると、過去に1週間ずつ遡った起点で予測した誤差(MAE, RMSE, MAPE, MASE)と予測区間に入った割合を返す
+// @tags Patients
+// @accept json
+// @produce json
+// @param area query string ture "都道府県名" example("東京都")
+// @param end_date query string false "学習に使う最後の日(yyyymmdd, yyyy-mm-dd, latest, -30d)。既定値は latest。その日のデータが無い場合は404" example(latest)
+// @param model query string false "予測モデル(log_linear, holt_winters)。既定値は holt_winters" example(holt_winters)
+// @param horizon query int false "予測する日数(1〜14)。既定値は14" example(14)
+// @param window query int false "学習に使う日数(21〜365)。既定値は42" example(42)
+// @param level query number false "予測区間の水準。既定値は0.95" example(0.95)
+// @param backtest query int false "検証する予測の起点の数(0〜52)。既定値は0(検証しない)。遡れるデータが足りない場合は400" example(8)
+// @param lang query string false "エラーメッセージの言語(ja, en)" example("en")
+// @Success 200
+// @failure 400
+// @failure 404
+// @failure 500
+// @failure 503
+// @router /patient/forecast [get]
+func GetPatientForecast(ctx context.Context, request transport.Request) (transport.Response, error) {
+	res, err := forecastService.GetForecast(ctx, service.ForecastRequest{
+		Area:     request.Query.Get("area"),
+		EndDate:  request.Query.Get("end_date"),
+		Model:    request.Query.Get("model"),
+		Horizon:  request.Query.Get("horizon"),
+		Window:   request.Query.Get("window"),
+		Level:    request.Query.Get("level"),
+		Backtest: request.Query.Get("backtest"),
+	})
+	if err != nil {
+		return transport.ErrorResponse(err, request.Language())
+	}
+	return transport.JSONResponse(http.StatusOK, res)
+}
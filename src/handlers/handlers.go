@@ -0,0 +1,22 @@
+package handlers
+
+import (
+	"corona-api/src/modules/date"
+	"time"
+)
+
+// 定期実行(Step Functions)の各Lambdaが返す処理結果
+const (
+	Failure = 0
+	Success = 1
+)
+
+// Clock は現在時刻の取得元(TZ が未設定なら Asia/Tokyo)。テストでは date.FixedClock に差し替える
+var Clock date.Clock = date.SystemClock{}
+
+// currentClock は差し替えた Clock をサービスにも使わせる
+type currentClock struct{}
+
+func (currentClock) Now() time.Time {
+	return Clock.Now()
+}
@@ -0,0 +1,127 @@
+package handlers
+
+import (
+	"context"
+	"corona-api/src/middleware"
+	"corona-api/src/modules/alert"
+	"corona-api/src/modules/ingestion"
+	"corona-api/src/modules/notification"
+	"corona-api/src/modules/patient"
+	"fmt"
+	"log"
+	"os"
+)
+
+// 通知の種類
+const (
+	NotifyModeFailure = "failure"
+	NotifyModeDigest  = "digest"
+	NotifyModeAlert   = "alert"
+)
+
+// NotifyExecutionEvent はステートマシンから渡される実行結果
+// Mode が空の場合は失敗の通知
+type NotifyExecutionEvent struct {
+	Mode        string               `json:"Mode"`
+	FailedState string               `json:"FailedState"`
+	ExecutionID string               `json:"ExecutionId"`
+	Input       NotifyExecutionInput `json:"Input"`
+}
+
+// NotifyExecutionInput は失敗したステートの入力(Lambdaの結果または Catch のエラー情報)
+type NotifyExecutionInput struct {
+	Status    *int   `json:"Status"`
+	ObjectKey string `json:"ObjectKey"`
+	RunID     int64  `json:"RunId"`
+	// Mode が alert の場合に通知するアラート
+	Alerts    []alert.Alert `json:"Alerts"`
+	ErrorInfo *struct {
+		Error string `json:"Error"`
+		Cause string `json:"Cause"`
+	} `json:"ErrorInfo"`
+}
+
+func (e NotifyExecutionEvent) failure() notification.Failure {
+	f := notification.Failure{
+		FailedState: e.FailedState,
+		ObjectKey:   e.Input.ObjectKey,
+		ExecutionID: e.ExecutionID,
+		RunID:       e.Input.RunID,
+		Region:      os.Getenv("REGION"),
+		Bucket:      fmt.Sprintf("patient-details-file-%s", os.Getenv("ENV")),
+	}
+	switch {
+	case e.Input.ErrorInfo != nil:
+		f.Error = e.Input.ErrorInfo.Error
+		f.Cause = e.Input.ErrorInfo.Cause
+	case e.Input.Status != nil && *e.Input.Status == Failure:
+		f.Error = "StatusFailure"
+		f.Cause = "Lambdaが失敗ステータスを返しました"
+	}
+	return f
+}
+
+func NotifyExecutionOfPatientDetailsSchedule(ctx context.Context, event NotifyExecutionEvent) error {
+	// 通知内容の作成
+	var message notification.Message
+	switch event.Mode {
+	case "", NotifyModeFailure:
+		message = event.failure().Message()
+	case NotifyModeDigest:
+		digest, err := event.digest(ctx)
+		if err != nil {
+			return err
+		}
+		message = digest.Message()
+	case NotifyModeAlert:
+		if len(event.Input.Alerts) == 0 {
+			return nil
+		}
+		message = notification.NewAlertMessage(event.Input.Alerts)
+	default:
+		return fmt.Errorf("unsupported notify mode: %q", event.Mode)
+	}
+
+	// 通知先の取得
+	channels, err := notification.LoadChannels()
+	if err != nil {
+		return err
+	}
+	if len(channels) == 0 {
+		log.Println("notification channels are not configured")
+		return nil
+	}
+	notifier, err := notification.NewMultiNotifier(channels)
+	if err != nil {
+		return err
+	}
+
+	// 全ての通知先へ通知
+	return notifier.Notify(ctx, message)
+}
+
+// digest は patient_details と取り込み履歴から日次のまとめを作る
+func (e NotifyExecutionEvent) digest(ctx context.Context) (notification.Digest, error) {
+	// DB接続
+	db, err := middleware.ConnectDb()
+	if err != nil {
+		return notification.Digest{}, err
+	}
+	defer db.Close()
+
+	var run ingestion.Run
+	if e.Input.RunID != 0 {
+		run, err = ingestion.GetRun(ctx, db, e.Input.RunID)
+	} else {
+		run, err = ingestion.GetLastSuccessfulRun(ctx, db)
+	}
+	if err != nil {
+		return notification.Digest{}, err
+	}
+
+	summary, err := patient.GetDigest(ctx, db)
+	if err != nil {
+		return notification.Digest{}, err
+	}
+	return notification.Digest{Run: run, Summary: summary, ExecutionID: e.ExecutionID}, nil
+}
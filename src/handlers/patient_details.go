@@ -0,0 +1,54 @@
+package handlers
+
+import (
+	"context"
+	"corona-api/src/middleware"
+	"corona-api/src/service"
+	"corona-api/src/transport"
+	"net/http"
+)
+
+var patientDetailsService = service.NewPatientDetailsService(middleware.ConnectDb, currentClock{})
+
+// @summary	感染者数詳細リスト取得
+// @description 2020/05/09から前日までの指定都道府県の感染者数情報を取得する。calendar に日付ごとの曜日(weekday)と祝日かどうか(is_holiday)、period に解決した検索期間、statistics に記述統計(最小値・最大値とその日付、中央値、標準偏差、パーセンタイル、データがある日数と無い日数)を返す。metrics を指定すると日付ごとの派生指標を返す。anomalies に報告の偏りなどによる異常値(日付、値、予測値、ロバストzスコア、spike または drop)を返す
+// @tags Patients
+// @accept json
+// @produce json
+// @param start_date query string false "開始日(yyyymmdd, yyyy-mm-dd, latest, 最新のデータの日付からの相対指定 -30d, -4w)" example(20230101)
+// @param end_date query string false "終了日(start_date と同じ形式)" example(latest)
+// @param period query string false "期間(start_date, end_date の代わりに指定する。latest, last_30_days, last_4_weeks, this_week, this_month, 2022-Q3, 2022-09, 2022, 2023-01-01/2023-01-31)" example(last_4_weeks)
+// @param metrics query string false "カンマ区切りの派生指標(day_over_day, day_over_day_percent, week_over_week, doubling_time, halving_time, all)" example(day_over_day,week_over_week)
+// @param area query string ture "都道府県名" example("北海道")
+// @param lang query string false "エラーメッセージの言語(ja, en)" example("en")
+// @Success 200
+// @failure 400
+// @failure 404
+// @failure 500
+// @failure 503
+// @router /patient/details/ [get]
+func GetPatientDetails(ctx context.Context, request transport.Request) (transport.Response, error) {
+	// レスポンスの言語を決定
+	lang := request.Language()
+
+	// データを取得
+	res, err := patientDetailsService.GetPatientDetails(ctx, service.PatientDetailsRequest{
+		Area:      request.Query.Get("area"),
+		StartDate: request.Query.Get("start_date"),
+		EndDate:   request.Query.Get("end_date"),
+		Period:    request.Query.Get("period"),
+		Metrics:   request.Query.Get("metrics"),
+	})
+	if err != nil {
+		return transport.ErrorResponse(err, lang)
+	}
+
+	response, err := transport.JSONResponse(http.StatusOK, res)
+	if err != nil {
+		return transport.ErrorResponse(err, lang)
+	}
+	if !res.LastModified.IsZero() {
+		response.Headers.Set("Last-Modified", res.LastModified.UTC().Format(http.TimeFormat))
+	}
+	return response, nil
+}
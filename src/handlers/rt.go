@@ -0,0 +1,48 @@
+package handlers
+
+import (
+	"context"
+	"corona-api/src/middleware"
+	"corona-api/src/service"
+	"corona-api/src/transport"
+	"net/http"
+)
+
+var rtService = service.NewRtService(middleware.ConnectDb, currentClock{})
+
+// @summary	実効再生産数取得
+// @description 指定都道府県の日ごとの感染者数から Cori の方法で実効再生産数(Rt)を推定する。date までの window 日間の事後分布の平均、中央値と信用区間を返す。期間の指定は感染者数詳細リスト取得と同じ
+// @tags Patients
+// @accept json
+// @produce json
+// @param area query string ture "都道府県名" example("東京都")
+// @param start_date query string false "開始日(yyyymmdd, yyyy-mm-dd, latest, -30d)" example(-90d)
+// @param end_date query string false "終了日(start_date と同じ形式)" example(latest)
+// @param period query string false "期間(start_date, end_date の代わりに指定する。last_4_weeks, 2022-Q3 など)" example(last_4_weeks)
+// @param si_mean query number false "発症間隔の平均(日)。既定値は4.7" example(4.7)
+// @param si_sd query number false "発症間隔の標準偏差(日)。既定値は2.9" example(2.9)
+// @param window query int false "推定に使う日数(1〜28)。既定値は7" example(7)
+// @param credible_level query number false "信用区間の水準。既定値は0.95" example(0.95)
+// @param lang query string false "エラーメッセージの言語(ja, en)" example("en")
+// @Success 200
+// @failure 400
+// @failure 404
+// @failure 500
+// @failure 503
+// @router /patient/rt [get]
+func GetPatientRt(ctx context.Context, request transport.Request) (transport.Response, error) {
+	res, err := rtService.GetRt(ctx, service.RtRequest{
+		Area:               request.Query.Get("area"),
+		StartDate:          request.Query.Get("start_date"),
+		EndDate:            request.Query.Get("end_date"),
+		Period:             request.Query.Get("period"),
+		SerialIntervalMean: request.Query.Get("si_mean"),
+		SerialIntervalSD:   request.Query.Get("si_sd"),
+		Window:             request.Query.Get("window"),
+		CredibleLevel:      request.Query.Get("credible_level"),
+	})
+	if err != nil {
+		return transport.ErrorResponse(err, request.Language())
+	}
+	return transport.JSONResponse(http.StatusOK, res)
+}
@@ -0,0 +1,28 @@
+package handlers
+
+import (
+	"context"
+	"corona-api/src/middleware"
+	"corona-api/src/service"
+	"corona-api/src/transport"
+	"net/http"
+)
+
+var statusService = service.NewStatusService(middleware.ConnectDb)
+
+// @summary	データ取り込み状況取得
+// @description 最後に成功した取り込みと都道府県ごとの最新データの日付を取得する。anomalies に最新の14日間の全ての都道府県の異常値を返す
+// @tags Status
+// @accept json
+// @produce json
+// @Success 200
+// @failure 500
+// @failure 503
+// @router /status [get]
+func GetStatus(ctx context.Context, request transport.Request) (transport.Response, error) {
+	res, err := statusService.GetStatus(ctx)
+	if err != nil {
+		return transport.ErrorResponse(err, request.Language())
+	}
+	return transport.JSONResponse(http.StatusOK, res)
+}
@@ -0,0 +1,112 @@
+package handlers
+
+import (
+	"context"
+	"corona-api/src/middleware"
+	"corona-api/src/modules/blob"
+	"corona-api/src/modules/ingestion"
+	"corona-api/src/modules/outbox"
+	"corona-api/src/modules/patient"
+	"database/sql"
+	"log"
+)
+
+type UpdatePatientDetailsTableEvent struct {
+	ObjectKey string `json:"ObjectKey"`
+}
+
+type UpdatePatientDetailsTableResponse struct {
+	Status int   `json:"Status"`
+	RunID  int64 `json:"RunId,omitempty"`
+}
+
+func UpdatePatientDetailsTable(ctx context.Context, event UpdatePatientDetailsTableEvent) (UpdatePatientDetailsTableResponse, error) {
+	// DB接続
+	db, err := middleware.ConnectDb()
+	if err != nil {
+		return UpdatePatientDetailsTableResponse{Status: Failure}, err
+	}
+	defer db.Close()
+
+	// 保存先(S3、オフラインの場合はローカルのディレクトリ)からJSONファイルを取得して取り込む
+	store, err := blob.NewStore()
+	if err != nil {
+		return UpdatePatientDetailsTableResponse{Status: Failure}, err
+	}
+	run, err := IngestPatientDetails(ctx, db, event.ObjectKey, func() ([]byte, error) {
+		return store.Get(ctx, event.ObjectKey)
+	})
+	if run == nil {
+		return UpdatePatientDetailsTableResponse{Status: Failure}, err
+	}
+	if err != nil {
+		return UpdatePatientDetailsTableResponse{Status: Failure, RunID: run.ID}, err
+	}
+
+	// 更新イベントを配信する(失敗した配信は定期的に再送する)
+	if _, err := deliverWebhooks(ctx, db); err != nil {
+		log.Println(err)
+	}
+
+	return UpdatePatientDetailsTableResponse{
+		Status: Success,
+		RunID:  run.ID,
+	}, nil
+}
+
+// IngestPatientDetails は load で読み込んだCovid19JapanAllのJSONファイルを patient_details へ取り込み、取り込み履歴を記録する
+// 取り込み開始を記録できなかった場合は nil の履歴を返す
+func IngestPatientDetails(ctx context.Context, db *sql.DB, objectKey string, load func() ([]byte, error)) (*ingestion.Run, error) {
+	// 取り込み開始を記録
+	run, err := ingestion.StartRun(ctx, db, objectKey)
+	if err != nil {
+		return nil, err
+	}
+
+	err = updatePatientDetailsTable(ctx, db, load, run)
+
+	// 取り込み結果を記録
+	if finishErr := ingestion.FinishRun(ctx, db, run, err); finishErr != nil {
+		log.Println(finishErr)
+	}
+	return run, err
+}
+
+func updatePatientDetailsTable(ctx context.Context, db *sql.DB, load func() ([]byte, error), run *ingestion.Run) error {
+	file, err := load()
+	if err != nil {
+		return err
+	}
+	run.ContentHash = ingestion.ContentHash(file)
+
+	// インサート用の構造体へ変換する
+	patientDetails, err := patient.ParseCovid19JapanAll(file)
+	if err != nil {
+		return err
+	}
+	run.RowsRead = uint32(len(patientDetails))
+
+	// DBへ保存し、変わったデータの範囲を更新イベントとしてアウトボックスに登録する
+	txAdmin := middleware.NewTxAdmin(db)
+	return txAdmin.Transaction(ctx, func(ctx context.Context) error {
+		q := txAdmin.Querier(ctx)
+
+		before, err := patient.GetAllPatientDetails(ctx, q)
+		if err != nil {
+			return err
+		}
+		deleted, err := patient.InsertPatientDetails(ctx, txAdmin, patientDetails)
+		if err != nil {
+			return err
+		}
+		run.RowsDeleted = uint32(deleted)
+		run.RowsInserted = uint32(len(patientDetails))
+
+		changeSet := patient.Changes(before, patientDetails)
+		if changeSet.Empty() {
+			return nil
+		}
+		_, err = outbox.Enqueue(ctx, q, outbox.EventPatientDetailsUpdated, outbox.NewPatientDetailsUpdated(run.ID, changeSet, Clock.Now()))
+		return err
+	})
+}
@@ -0,0 +1,53 @@
+package handlers
+
+import (
+	"context"
+	"corona-api/src/modules/blob"
+	"corona-api/src/modules/date"
+	"corona-api/src/modules/patient"
+	"log"
+)
+
+const (
+	S3ObjectKeyTimeFormat = "20060102150405"
+)
+
+type UploadPatientDetailsFileResponse struct {
+	Status    int    `json:"Status"`
+	ObjectKey string `json:"ObjectKey"`
+}
+
+// ObjectKey は clock の現在時刻から保存先のオブジェクトキー(yyyymmddhhmmss)を作る
+func ObjectKey(clock date.Clock) string {
+	return clock.Now().Format(S3ObjectKeyTimeFormat)
+}
+
+func UploadPatientDetailsFile(ctx context.Context) (UploadPatientDetailsFileResponse, error) {
+	// 外部APIからJSONファイルを取得
+	file, err := patient.FetchCovid19JapanAll(ctx)
+	if err != nil {
+		log.Println(err)
+		return UploadPatientDetailsFileResponse{Status: Failure}, err
+	}
+
+	// 保存先(S3、オフラインの場合はローカルのディレクトリ)
+	store, err := blob.NewStore()
+	if err != nil {
+		log.Println(err)
+		return UploadPatientDetailsFileResponse{Status: Failure}, err
+	}
+
+	// 現在時刻をオブジェクトキーに設定
+	objectKey := ObjectKey(Clock)
+
+	// 取得したファイルを保存
+	if err := store.Put(ctx, objectKey, file); err != nil {
+		log.Println(err)
+		return UploadPatientDetailsFileResponse{Status: Failure}, err
+	}
+
+	return UploadPatientDetailsFileResponse{
+		Status:    Success,
+		ObjectKey: objectKey,
+	}, nil
+}
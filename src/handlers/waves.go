@@ -0,0 +1,48 @@
+package handlers
+
+import (
+	"context"
+	"corona-api/src/middleware"
+	"corona-api/src/service"
+	"corona-api/src/transport"
+	"net/http"
+)
+
+var wavesService = service.NewWavesService(middleware.ConnectDb, currentClock{})
+
+// @summary	流行の波の取得
+// @description 指定都道府県の日ごとの感染者数を移動平均で平滑化し、ピークと谷から流行の波(第N波)を検出する。波ごとに開始日、ピークの日と値、終了日、感染者数の合計を返す。期間を指定しなければ全ての期間から検出する
+// @tags Patients
+// @accept json
+// @produce json
+// @param area query string ture "都道府県名" example("東京都")
+// @param start_date query string false "開始日(yyyymmdd, yyyy-mm-dd, latest, -30d)" example(20200509)
+// @param end_date query string false "終了日(start_date と同じ形式)" example(latest)
+// @param period query string false "期間(start_date, end_date の代わりに指定する。2022, 2022-Q3 など)" example(2022)
+// @param smoothing_window query int false "平滑化(中心移動平均)の日数(1〜28)。既定値は7" example(7)
+// @param min_prominence query number false "ピークの高さに対するプロミネンスの割合の下限(0〜1)。既定値は0.5" example(0.5)
+// @param min_distance query int false "ピークの間隔の下限(0〜365日)。既定値は30" example(30)
+// @param min_peak query number false "平滑化したピークの値の下限。既定値は1" example(1)
+// @param lang query string false "エラーメッセージの言語(ja, en)" example("en")
+// @Success 200
+// @failure 400
+// @failure 404
+// @failure 500
+// @failure 503
+// @router /patient/waves [get]
+func GetPatientWaves(ctx context.Context, request transport.Request) (transport.Response, error) {
+	res, err := wavesService.GetWaves(ctx, service.WavesRequest{
+		Area:            request.Query.Get("area"),
+		StartDate:       request.Query.Get("start_date"),
+		EndDate:         request.Query.Get("end_date"),
+		Period:          request.Query.Get("period"),
+		SmoothingWindow: request.Query.Get("smoothing_window"),
+		MinProminence:   request.Query.Get("min_prominence"),
+		MinDistance:     request.Query.Get("min_distance"),
+		MinPeak:         request.Query.Get("min_peak"),
+	})
+	if err != nil {
+		return transport.ErrorResponse(err, request.Language())
+	}
+	return transport.JSONResponse(http.StatusOK, res)
+}
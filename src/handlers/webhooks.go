@@ -0,0 +1,137 @@
+package handlers
+
+import (
+	"context"
+	"corona-api/src/middleware"
+	"corona-api/src/modules/common"
+	"corona-api/src/service"
+	"corona-api/src/transport"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+var webhookService = service.NewWebhookService(middleware.ConnectDb)
+
+// @summary	Webhook登録
+// @description patient_details の更新イベント(patient_details.updated)を配信する Webhook を登録する。署名鍵はこのレスポンスでだけ返す
+// @tags Webhooks
+// @accept json
+// @produce json
+// @param X-Api-Key header string true "管理用APIのキー"
+// @param request body service.CreateWebhookRequest true "配信先"
+// @Success 201
+// @failure 400
+// @failure 401
+// @failure 500
+// @failure 503
+// @router /webhooks [post]
+func CreateWebhook(ctx context.Context, request transport.Request) (transport.Response, error) {
+	lang := request.Language()
+	if err := authorize(request); err != nil {
+		return transport.ErrorResponse(err, lang)
+	}
+
+	var body service.CreateWebhookRequest
+	if err := json.Unmarshal(request.Body, &body); err != nil {
+		return transport.ErrorResponse(common.NewValidationError(fmt.Errorf("json.Unmarshal() error: %v", err), common.FieldError{Field: "body", Reason: common.FieldReasonInvalidFormat}), lang)
+	}
+
+	res, err := webhookService.CreateWebhook(ctx, body)
+	if err != nil {
+		return transport.ErrorResponse(err, lang)
+	}
+	return transport.JSONResponse(http.StatusCreated, res)
+}
+
+// @summary	Webhook一覧取得
+// @description 登録されている Webhook を取得する
+// @tags Webhooks
+// @accept json
+// @produce json
+// @param X-Api-Key header string true "管理用APIのキー"
+// @Success 200
+// @failure 401
+// @failure 500
+// @failure 503
+// @router /webhooks [get]
+func ListWebhooks(ctx context.Context, request transport.Request) (transport.Response, error) {
+	if err := authorize(request); err != nil {
+		return transport.ErrorResponse(err, request.Language())
+	}
+	res, err := webhookService.ListWebhooks(ctx)
+	if err != nil {
+		return transport.ErrorResponse(err, request.Language())
+	}
+	return transport.JSONResponse(http.StatusOK, res)
+}
+
+// @summary	Webhook削除
+// @description Webhook を削除する。未配信の配信はデッドレターになる
+// @tags Webhooks
+// @accept json
+// @produce json
+// @param X-Api-Key header string true "管理用APIのキー"
+// @param id path int true "WebhookID" example(1)
+// @Success 204
+// @failure 400
+// @failure 401
+// @failure 404
+// @failure 500
+// @failure 503
+// @router /webhooks/{id} [delete]
+func DeleteWebhook(ctx context.Context, request transport.Request) (transport.Response, error) {
+	if err := authorize(request); err != nil {
+		return transport.ErrorResponse(err, request.Language())
+	}
+	if err := webhookService.DeleteWebhook(ctx, request.PathParams["id"]); err != nil {
+		return transport.ErrorResponse(err, request.Language())
+	}
+	return transport.Response{StatusCode: http.StatusNoContent, Headers: http.Header{}}, nil
+}
+
+// @summary	デッドレター一覧取得
+// @description 再送の上限に達した配信を新しい順に100件取得する
+// @tags Webhooks
+// @accept json
+// @produce json
+// @param X-Api-Key header string true "管理用APIのキー"
+// @Success 200
+// @failure 401
+// @failure 500
+// @failure 503
+// @router /webhooks/dead-letters [get]
+func ListDeadLetters(ctx context.Context, request transport.Request) (transport.Response, error) {
+	if err := authorize(request); err != nil {
+		return transport.ErrorResponse(err, request.Language())
+	}
+	res, err := webhookService.ListDeadLetters(ctx)
+	if err != nil {
+		return transport.ErrorResponse(err, request.Language())
+	}
+	return transport.JSONResponse(http.StatusOK, res)
+}
+
+// @summary	デッドレター再送
+// @description デッドレターを再送の対象に戻す。次回の配信(5分ごと)で送る
+// @tags Webhooks
+// @accept json
+// @produce json
+// @param X-Api-Key header string true "管理用APIのキー"
+// @param id path int true "配信ID" example(1)
+// @Success 204
+// @failure 400
+// @failure 401
+// @failure 404
+// @failure 500
+// @failure 503
+// @router /webhooks/dead-letters/{id}/replay [post]
+func ReplayDeadLetter(ctx context.Context, request transport.Request) (transport.Response, error) {
+	if err := authorize(request); err != nil {
+		return transport.ErrorResponse(err, request.Language())
+	}
+	if err := webhookService.ReplayDeadLetter(ctx, request.PathParams["id"]); err != nil {
+		return transport.ErrorResponse(err, request.Language())
+	}
+	return transport.Response{StatusCode: http.StatusNoContent, Headers: http.Header{}}, nil
+}
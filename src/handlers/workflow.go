@@ -0,0 +1,54 @@
+package handlers
+
+import (
+	"context"
+	"corona-api/src/modules/workflow"
+	"encoding/json"
+)
+
+// 定期実行のステートマシンから呼ばれるLambda関数の論理ID
+const (
+	UploadPatientDetailsFileTaskName                = "UploadPatientDetailsFileToS3Function"
+	UpdatePatientDetailsTableTaskName               = "UpdatePatientDetailsTableFunction"
+	NotifyExecutionOfPatientDetailsScheduleTaskName = "NotifyExecutionOfPatientDetailsScheduleFunction"
+	EvaluateAlertRulesTaskName                      = "EvaluateAlertRulesFunction"
+)
+
+// RegisterWorkflowTasks は定期実行の各Lambdaをプロセス内で実行できるように登録する
+func RegisterWorkflowTasks(r *workflow.Runner) {
+	r.Register(UploadPatientDetailsFileTaskName, func(ctx context.Context, payload []byte) ([]byte, error) {
+		res, err := UploadPatientDetailsFile(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return json.Marshal(res)
+	})
+	r.Register(UpdatePatientDetailsTableTaskName, func(ctx context.Context, payload []byte) ([]byte, error) {
+		var event UpdatePatientDetailsTableEvent
+		if err := json.Unmarshal(payload, &event); err != nil {
+			return nil, err
+		}
+		res, err := UpdatePatientDetailsTable(ctx, event)
+		if err != nil {
+			return nil, err
+		}
+		return json.Marshal(res)
+	})
+	r.Register(EvaluateAlertRulesTaskName, func(ctx context.Context, payload []byte) ([]byte, error) {
+		res, err := EvaluateAlertRules(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return json.Marshal(res)
+	})
+	r.Register(NotifyExecutionOfPatientDetailsScheduleTaskName, func(ctx context.Context, payload []byte) ([]byte, error) {
+		var event NotifyExecutionEvent
+		if err := json.Unmarshal(payload, &event); err != nil {
+			return nil, err
+		}
+		if err := NotifyExecutionOfPatientDetailsSchedule(ctx, event); err != nil {
+			return nil, err
+		}
+		return []byte("null"), nil
+	})
+}
@@ -0,0 +1,58 @@
+package middleware
+
+import (
+	"fmt"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"os"
+	"sync"
+)
+
+const (
+	// 管理用APIのキーを保存しているパラメータ名の環境変数
+	ManagementAPIKeySettingEnv = "MANAGEMENT_API_KEY_SETTING"
+	// ローカル環境で管理用APIのキーを直接指定する環境変数
+	ManagementAPIKeyEnv = "MANAGEMENT_API_KEY"
+)
+
+// パラメータストアから取得したキーはコンテナが再利用される間は使い回す
+var (
+	managementAPIKeyMu sync.Mutex
+	managementAPIKey   string
+)
+
+// LoadManagementAPIKey は購読や Webhook を管理するAPIのキーを取得する
+// キーを設定していない場合は空文字を返す(全てのリクエストを拒否する)
+func LoadManagementAPIKey() (string, error) {
+	// ローカル環境は環境変数から取得
+	if os.Getenv("GO_ENV") == "local" {
+		return os.Getenv(ManagementAPIKeyEnv), nil
+	}
+
+	name := os.Getenv(ManagementAPIKeySettingEnv)
+	if name == "" {
+		return "", nil
+	}
+
+	managementAPIKeyMu.Lock()
+	defer managementAPIKeyMu.Unlock()
+	if managementAPIKey != "" {
+		return managementAPIKey, nil
+	}
+
+	// パラメータストア接続
+	svc := ssm.New(
+		session.Must(session.NewSession()),
+		aws.NewConfig().WithRegion(os.Getenv("REGION")),
+	)
+	res, err := svc.GetParameter(&ssm.GetParameterInput{
+		Name:           aws.String(name),
+		WithDecryption: aws.Bool(true),
+	})
+	if err != nil {
+		return "", fmt.Errorf("svc.GetParameter() error: %w", err)
+	}
+	managementAPIKey = *res.Parameter.Value
+	return managementAPIKey, nil
+}
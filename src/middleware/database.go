@@ -1,7 +1,6 @@
 package middleware
 
 import (
-	"context"
 	"database/sql"
 	"encoding/json"
 	"fmt"
@@ -9,14 +8,25 @@ import (
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/ssm"
 	_ "github.com/go-sql-driver/mysql"
+	_ "github.com/mattn/go-sqlite3"
 	"github.com/rs/zerolog"
 	sqldblogger "github.com/simukti/sqldb-logger"
 	"github.com/simukti/sqldb-logger/logadapter/zerologadapter"
+	"io"
 	"log"
 	"os"
+	"path/filepath"
+)
+
+const (
+	DriverMySQL  = "mysql"
+	DriverSQLite = "sqlite3"
 )
 
 type Database struct {
+	// Driver が sqlite3 の場合は Path のSQLiteファイルを使う(AWSを使わないオフライン用)
+	Driver   string `json:"driver,omitempty"`
+	Path     string `json:"path,omitempty"`
 	User     string `json:"user,omitempty"`
 	Password string `json:"password,omitempty"`
 	Host     string `json:"host,omitempty"`
@@ -25,61 +35,87 @@ type Database struct {
 	Charset  string `json:"charset,omitempty"`
 }
 
-type txAdmin struct {
-	*sql.DB
-}
-
-type Service struct {
-	tx txAdmin
-}
-
 func ConnectDb() (*sql.DB, error) {
-	// パラメータストア接続
-	svc := ssm.New(
-		session.Must(session.NewSession()),
-		aws.NewConfig().WithRegion(os.Getenv("REGION")),
-	)
+	return ConnectDbWithQueryLog(os.Stdout)
+}
 
-	res, err := svc.GetParameter(&ssm.GetParameterInput{
-		Name:           aws.String(os.Getenv("DB_CONNECTION_SETTING")),
-		WithDecryption: aws.Bool(true),
-	})
+// ConnectDbWithQueryLog はSQLのクエリログを w へ出力する。w が nil ならクエリログを出力しない
+func ConnectDbWithQueryLog(w io.Writer) (*sql.DB, error) {
+	database, err := loadDatabaseSetting()
 	if err != nil {
-		panic(err)
-	}
-	database := Database{}
-	err = json.Unmarshal([]byte(*res.Parameter.Value), &database)
-	if err != nil {
-		panic(err)
+		return nil, err
 	}
 
 	// DB接続
-	dbConfig := fmt.Sprintf("%s:%s@tcp(%s:%s)/%s?charset=%s", database.User, database.Password, database.Host, database.Port, database.Name, database.Charset)
+	driver, dbConfig, err := database.dataSource()
 	if err != nil {
-		log.Println(dbConfig)
-		panic(err)
+		return nil, err
 	}
-	db, err := sql.Open("mysql", dbConfig)
+	db, err := sql.Open(driver, dbConfig)
 	if err != nil {
 		log.Println(dbConfig)
 		return nil, err
 	}
 
 	// SQLのクエリログを取得
-	loggerAdapter := zerologadapter.New(zerolog.New(os.Stdout))
+	if w == nil {
+		return db, nil
+	}
+	loggerAdapter := zerologadapter.New(zerolog.New(w))
 	db = sqldblogger.OpenDriver(dbConfig, db.Driver(), loggerAdapter)
 
 	return db, nil
 }
 
-func (t *txAdmin) Transaction(ctx context.Context, f func(ctx context.Context) (err error)) error {
-	tx, err := t.BeginTx(ctx, nil)
+func (d Database) dataSource() (string, string, error) {
+	switch d.Driver {
+	case "", DriverMySQL:
+		return DriverMySQL, fmt.Sprintf("%s:%s@tcp(%s:%s)/%s?charset=%s&parseTime=true", d.User, d.Password, d.Host, d.Port, d.Name, d.Charset), nil
+	case DriverSQLite:
+		if d.Path == "" {
+			return "", "", fmt.Errorf("database path is empty")
+		}
+		if err := os.MkdirAll(filepath.Dir(d.Path), 0o755); err != nil {
+			return "", "", fmt.Errorf("os.MkdirAll() error: %w", err)
+		}
+		// 書き込みが重なったときはロックの解放を待つ
+		return DriverSQLite, fmt.Sprintf("file:%s?_busy_timeout=5000&_journal_mode=WAL&_txlock=immediate", d.Path), nil
+	}
+	return "", "", fmt.Errorf("unknown database driver: %v", d.Driver)
+}
+
+func loadDatabaseSetting() (Database, error) {
+	// ローカル環境は環境変数から接続情報を取得
+	if os.Getenv("GO_ENV") == "local" {
+		return Database{
+			Driver:   os.Getenv("DB_DRIVER"),
+			Path:     os.Getenv("DB_PATH"),
+			User:     os.Getenv("DB_USER"),
+			Password: os.Getenv("DB_PASSWORD"),
+			Host:     os.Getenv("DB_HOST"),
+			Port:     os.Getenv("DB_PORT"),
+			Name:     os.Getenv("DB_NAME"),
+			Charset:  os.Getenv("DB_CHARSET"),
+		}, nil
+	}
+
+	// パラメータストア接続
+	svc := ssm.New(
+		session.Must(session.NewSession()),
+		aws.NewConfig().WithRegion(os.Getenv("REGION")),
+	)
+
+	res, err := svc.GetParameter(&ssm.GetParameterInput{
+		Name:           aws.String(os.Getenv("DB_CONNECTION_SETTING")),
+		WithDecryption: aws.Bool(true),
+	})
 	if err != nil {
-		return err
+		return Database{}, fmt.Errorf("svc.GetParameter() error: %w", err)
 	}
-	defer tx.Rollback()
-	if err := f(ctx); err != nil {
-		return fmt.Errorf("query faild: %w", err)
+	database := Database{}
+	err = json.Unmarshal([]byte(*res.Parameter.Value), &database)
+	if err != nil {
+		return Database{}, fmt.Errorf("json.Unmarshal() error: %w", err)
 	}
-	return tx.Commit()
+	return database, nil
 }
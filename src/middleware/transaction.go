@@ -0,0 +1,93 @@
+package middleware
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/go-sql-driver/mysql"
+	"time"
+)
+
+const (
+	TransactionMaxRetryCount = 3
+	TransactionRetryWaitTime = 100 * time.Millisecond
+)
+
+const (
+	mysqlErrLockWaitTimeout = 1205
+	mysqlErrLockDeadlock    = 1213
+)
+
+// Querier は *sql.DB と *sql.Tx の両方が満たすクエリ実行用のインターフェース
+type Querier interface {
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+	PrepareContext(ctx context.Context, query string) (*sql.Stmt, error)
+	QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row
+}
+
+type txKey struct{}
+
+type TxAdmin struct {
+	*sql.DB
+}
+
+func NewTxAdmin(db *sql.DB) *TxAdmin {
+	return &TxAdmin{db}
+}
+
+// Querier はコンテキストにトランザクションがあればそれを、なければDBを返す
+func (t *TxAdmin) Querier(ctx context.Context) Querier {
+	if tx, ok := ctx.Value(txKey{}).(*sql.Tx); ok {
+		return tx
+	}
+	return t.DB
+}
+
+// Transaction は f をトランザクション内で実行する
+// 既にトランザクション内で呼ばれた場合は外側のトランザクションに参加する
+// デッドロック等で失敗した場合は f ごとリトライする
+func (t *TxAdmin) Transaction(ctx context.Context, f func(ctx context.Context) (err error)) error {
+	// ネストした呼び出しは外側のトランザクションに任せる
+	if _, ok := ctx.Value(txKey{}).(*sql.Tx); ok {
+		return f(ctx)
+	}
+
+	var err error
+	for i := 0; i <= TransactionMaxRetryCount; i++ {
+		if i > 0 {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(TransactionRetryWaitTime * time.Duration(1<<uint(i-1))):
+			}
+		}
+		err = t.transaction(ctx, f)
+		if err == nil || !isRetryableError(err) {
+			return err
+		}
+	}
+	return fmt.Errorf("transaction retry count exceeded: %w", err)
+}
+
+func (t *TxAdmin) transaction(ctx context.Context, f func(ctx context.Context) (err error)) error {
+	tx, err := t.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	if err := f(context.WithValue(ctx, txKey{}, tx)); err != nil {
+		return fmt.Errorf("query failed: %w", err)
+	}
+	return tx.Commit()
+}
+
+// isRetryableError はデッドロック・ロック待ちタイムアウトを判定する
+func isRetryableError(err error) bool {
+	var mysqlErr *mysql.MySQLError
+	if errors.As(err, &mysqlErr) {
+		return mysqlErr.Number == mysqlErrLockDeadlock || mysqlErr.Number == mysqlErrLockWaitTimeout
+	}
+	return isSQLiteBusyError(err)
+}
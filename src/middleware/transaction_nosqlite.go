@@ -0,0 +1,9 @@
+//go:build !cgo
+// +build !cgo
+
+package middleware
+
+// SQLite のドライバーは cgo が必要なため、cgo なしのビルド(Lambda)では判定しない
+func isSQLiteBusyError(err error) bool {
+	return false
+}
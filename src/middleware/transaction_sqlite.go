@@ -0,0 +1,18 @@
+//go:build cgo
+// +build cgo
+
+package middleware
+
+import (
+	"errors"
+	"github.com/mattn/go-sqlite3"
+)
+
+// isSQLiteBusyError は SQLite のロック待ちを判定する
+func isSQLiteBusyError(err error) bool {
+	var sqliteErr sqlite3.Error
+	if !errors.As(err, &sqliteErr) {
+		return false
+	}
+	return sqliteErr.Code == sqlite3.ErrBusy || sqliteErr.Code == sqlite3.ErrLocked
+}
@@ -0,0 +1,167 @@
+//go:build cgo
+// +build cgo
+
+package middleware
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func Test_isRetryableError_SQLite(t *testing.T) {
+	assert.True(t, isRetryableError(fmt.Errorf("query failed: %w", sqlite3.Error{Code: sqlite3.ErrBusy})))
+	assert.True(t, isRetryableError(sqlite3.Error{Code: sqlite3.ErrLocked}))
+	assert.False(t, isRetryableError(sqlite3.Error{Code: sqlite3.ErrConstraint}))
+}
+
+// openMemoryDB は1つの接続だけを使うインメモリの SQLite を開く(接続ごとに別のDBになるため)
+func openMemoryDB(t *testing.T) *sql.DB {
+	db, err := sql.Open(DriverSQLite, ":memory:")
+	assert.NoError(t, err)
+	db.SetMaxOpenConns(1)
+	_, err = db.Exec("CREATE TABLE items (id INTEGER NOT NULL)")
+	assert.NoError(t, err)
+	return db
+}
+
+func insertItem(ctx context.Context, q Querier, id int) error {
+	_, err := q.ExecContext(ctx, "INSERT INTO items (id) VALUES (?)", id)
+	return err
+}
+
+func TestTxAdmin_Transaction_SQLite(t *testing.T) {
+	errCallback := errors.New("callback failed")
+	tests := []struct {
+		name     string
+		f        func(ctx context.Context, txAdmin *TxAdmin, attempts *int) error
+		wantErr  error
+		wantRows int
+		// wantAttempts はコールバックが呼ばれる回数
+		wantAttempts int
+	}{
+		{
+			name: "commit",
+			f: func(ctx context.Context, txAdmin *TxAdmin, attempts *int) error {
+				return txAdmin.Transaction(ctx, func(ctx context.Context) error {
+					*attempts++
+					// コンテキストのトランザクションでクエリを実行する
+					if _, ok := txAdmin.Querier(ctx).(*sql.Tx); !ok {
+						return errors.New("querier is not *sql.Tx")
+					}
+					return insertItem(ctx, txAdmin.Querier(ctx), 1)
+				})
+			},
+			wantRows:     1,
+			wantAttempts: 1,
+		},
+		{
+			name: "rollback on error",
+			f: func(ctx context.Context, txAdmin *TxAdmin, attempts *int) error {
+				return txAdmin.Transaction(ctx, func(ctx context.Context) error {
+					*attempts++
+					if err := insertItem(ctx, txAdmin.Querier(ctx), 1); err != nil {
+						return err
+					}
+					return errCallback
+				})
+			},
+			wantErr:      errCallback,
+			wantAttempts: 1,
+		},
+		{
+			name: "nested joins outer transaction",
+			f: func(ctx context.Context, txAdmin *TxAdmin, attempts *int) error {
+				return txAdmin.Transaction(ctx, func(ctx context.Context) error {
+					*attempts++
+					outer := txAdmin.Querier(ctx)
+					if err := insertItem(ctx, outer, 1); err != nil {
+						return err
+					}
+					return txAdmin.Transaction(ctx, func(ctx context.Context) error {
+						// 接続は1つなので、新しいトランザクションを始めるとタイムアウトする
+						if txAdmin.Querier(ctx) != outer {
+							return errors.New("nested transaction did not join outer transaction")
+						}
+						return insertItem(ctx, txAdmin.Querier(ctx), 2)
+					})
+				})
+			},
+			wantRows:     2,
+			wantAttempts: 1,
+		},
+		{
+			name: "nested failure rolls back outer insert",
+			f: func(ctx context.Context, txAdmin *TxAdmin, attempts *int) error {
+				return txAdmin.Transaction(ctx, func(ctx context.Context) error {
+					*attempts++
+					if err := insertItem(ctx, txAdmin.Querier(ctx), 1); err != nil {
+						return err
+					}
+					return txAdmin.Transaction(ctx, func(ctx context.Context) error {
+						return errCallback
+					})
+				})
+			},
+			wantErr:      errCallback,
+			wantAttempts: 1,
+		},
+		{
+			name: "retry on busy",
+			f: func(ctx context.Context, txAdmin *TxAdmin, attempts *int) error {
+				return txAdmin.Transaction(ctx, func(ctx context.Context) error {
+					*attempts++
+					if err := insertItem(ctx, txAdmin.Querier(ctx), *attempts); err != nil {
+						return err
+					}
+					// 1回目はロック待ちで失敗したことにする(1回目の挿入はロールバックされる)
+					if *attempts == 1 {
+						return sqlite3.Error{Code: sqlite3.ErrBusy}
+					}
+					return nil
+				})
+			},
+			wantRows:     1,
+			wantAttempts: 2,
+		},
+		{
+			name: "retry count exceeded",
+			f: func(ctx context.Context, txAdmin *TxAdmin, attempts *int) error {
+				return txAdmin.Transaction(ctx, func(ctx context.Context) error {
+					*attempts++
+					return sqlite3.Error{Code: sqlite3.ErrLocked}
+				})
+			},
+			wantErr:      sqlite3.Error{Code: sqlite3.ErrLocked},
+			wantAttempts: TransactionMaxRetryCount + 1,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			db := openMemoryDB(t)
+			defer db.Close()
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+
+			var attempts int
+			err := tt.f(ctx, NewTxAdmin(db), &attempts)
+			if tt.wantErr != nil {
+				assert.True(t, errors.Is(err, tt.wantErr), err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.wantAttempts, attempts)
+
+			var rows int
+			assert.NoError(t, db.QueryRow("SELECT COUNT(*) FROM items").Scan(&rows))
+			assert.Equal(t, tt.wantRows, rows)
+		})
+	}
+}
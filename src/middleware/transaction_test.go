@@ -0,0 +1,30 @@
+package middleware
+
+import (
+	"errors"
+	"fmt"
+	"github.com/go-sql-driver/mysql"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func Test_isRetryableError(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{name: "deadlock", err: &mysql.MySQLError{Number: mysqlErrLockDeadlock}, want: true},
+		{name: "lock wait timeout", err: &mysql.MySQLError{Number: mysqlErrLockWaitTimeout}, want: true},
+		{name: "wrapped deadlock", err: fmt.Errorf("query failed: %w", &mysql.MySQLError{Number: mysqlErrLockDeadlock}), want: true},
+		{name: "duplicate entry", err: &mysql.MySQLError{Number: 1062}, want: false},
+		{name: "other error", err: errors.New("other"), want: false},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.want, isRetryableError(tt.err))
+		})
+	}
+}
@@ -0,0 +1,161 @@
+package alert
+
+import (
+	"corona-api/src/modules/date"
+	"corona-api/src/modules/patient"
+	"fmt"
+	"sort"
+)
+
+// ルールの種類
+const (
+	// RuleKindWeekOverWeek は7日間平均の前週比(%)が閾値を超えたら発火する
+	RuleKindWeekOverWeek = "week_over_week"
+	// RuleKindLevel は7日間平均が閾値以上になったら発火する
+	RuleKindLevel = "level"
+)
+
+const (
+	AverageDays = 7
+)
+
+// Rule はアラートの条件
+// Area が空の場合は全ての都道府県を対象にする
+type Rule struct {
+	ID        int64
+	Name      string
+	Area      string
+	Kind      string
+	Threshold float64
+	Enabled   bool
+}
+
+func (r Rule) Validate() error {
+	switch r.Kind {
+	case RuleKindWeekOverWeek, RuleKindLevel:
+	default:
+		return fmt.Errorf("rule %v: unsupported kind: %q", r.ID, r.Kind)
+	}
+	return nil
+}
+
+// Average は都道府県ごとの7日間平均
+type Average struct {
+	Area            string
+	Date            date.Date
+	Average         float64
+	PreviousAverage float64
+}
+
+// Change は前週比(%)。前週が0の場合は false を返す
+func (a Average) Change() (float64, bool) {
+	if a.PreviousAverage == 0 {
+		return 0, false
+	}
+	return (a.Average - a.PreviousAverage) / a.PreviousAverage * 100, true
+}
+
+// Alert は発火したアラート(Step Functions で通知の Lambda へ渡す)
+type Alert struct {
+	RuleID          int64     `json:"RuleId"`
+	RuleName        string    `json:"RuleName"`
+	Kind            string    `json:"Kind"`
+	Threshold       float64   `json:"Threshold"`
+	Area            string    `json:"Area"`
+	Date            date.Date `json:"Date"`
+	Average         float64   `json:"Average"`
+	PreviousAverage float64   `json:"PreviousAverage"`
+	Change          float64   `json:"Change"`
+}
+
+// Result はルールと都道府県ごとの評価結果
+type Result struct {
+	Alert
+	Triggered bool
+}
+
+// Averages は最新日付の7日間平均と前週の7日間平均を計算する
+// データがない日は0人として扱う
+func Averages(patientDetails []patient.Detail, latestDate date.Date) []Average {
+	week := date.LastDays(latestDate, AverageDays)
+	previousWeek := week.Previous()
+
+	sums := map[string]*Average{}
+	for _, pd := range patientDetails {
+		if !week.Contains(pd.Date) && !previousWeek.Contains(pd.Date) {
+			continue
+		}
+		a, ok := sums[pd.Area]
+		if !ok {
+			a = &Average{Area: pd.Area, Date: latestDate}
+			sums[pd.Area] = a
+		}
+		if week.Contains(pd.Date) {
+			a.Average += float64(pd.Value)
+		} else {
+			a.PreviousAverage += float64(pd.Value)
+		}
+	}
+
+	averages := make([]Average, 0, len(sums))
+	for _, a := range sums {
+		a.Average /= AverageDays
+		a.PreviousAverage /= AverageDays
+		averages = append(averages, *a)
+	}
+	sort.Slice(averages, func(i, j int) bool { return averages[i].Area < averages[j].Area })
+	return averages
+}
+
+// Evaluate はルールを都道府県ごとの7日間平均で評価する
+func Evaluate(rules []Rule, averages []Average) []Result {
+	var results []Result
+	for _, rule := range rules {
+		if !rule.Enabled {
+			continue
+		}
+		for _, a := range averages {
+			if rule.Area != "" && rule.Area != a.Area {
+				continue
+			}
+			change, hasChange := a.Change()
+			result := Result{Alert: Alert{
+				RuleID:          rule.ID,
+				RuleName:        rule.Name,
+				Kind:            rule.Kind,
+				Threshold:       rule.Threshold,
+				Area:            a.Area,
+				Date:            a.Date,
+				Average:         a.Average,
+				PreviousAverage: a.PreviousAverage,
+				Change:          change,
+			}}
+			switch rule.Kind {
+			case RuleKindWeekOverWeek:
+				result.Triggered = hasChange && change > rule.Threshold
+			case RuleKindLevel:
+				result.Triggered = a.Average >= rule.Threshold
+			}
+			results = append(results, result)
+		}
+	}
+	return results
+}
+
+// StateKey はアラートの状態のキー
+type StateKey struct {
+	RuleID int64
+	Area   string
+}
+
+// Deduplicate は発火中でなかったアラートだけを返す
+// 条件を満たし続けている間は通知せず、一度条件を外れたら再び通知する
+func Deduplicate(results []Result, states map[StateKey]State) []Alert {
+	var alerts []Alert
+	for _, result := range results {
+		if result.Triggered && !states[StateKey{result.RuleID, result.Area}].Active {
+			alerts = append(alerts, result.Alert)
+		}
+	}
+	return alerts
+}
@@ -0,0 +1,131 @@
+package alert
+
+import (
+	"context"
+	"corona-api/src/middleware"
+	"corona-api/src/modules/date"
+	"corona-api/src/modules/patient"
+	"fmt"
+	"time"
+)
+
+// State はルールと都道府県ごとの発火状態
+type State struct {
+	Active        bool
+	LastFiredDate date.Date
+}
+
+// GetEnabledRules は有効なルールを取得する
+func GetEnabledRules(ctx context.Context, q middleware.Querier) ([]Rule, error) {
+	rows, err := q.QueryContext(ctx, "SELECT id, name, area, kind, threshold, enabled FROM alert_rules WHERE enabled = 1 ORDER BY id")
+	if err != nil {
+		return nil, fmt.Errorf("db.Query() error: %v", err)
+	}
+	defer rows.Close()
+
+	var rules []Rule
+	for rows.Next() {
+		var rule Rule
+		if err := rows.Scan(&rule.ID, &rule.Name, &rule.Area, &rule.Kind, &rule.Threshold, &rule.Enabled); err != nil {
+			return nil, fmt.Errorf("rows.Scan() error: %v", err)
+		}
+		if err := rule.Validate(); err != nil {
+			return nil, err
+		}
+		rules = append(rules, rule)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows.Err() error: %v", err)
+	}
+	return rules, nil
+}
+
+// GetStates は全てのルールと都道府県の発火状態を取得する
+func GetStates(ctx context.Context, q middleware.Querier) (map[StateKey]State, error) {
+	rows, err := q.QueryContext(ctx, "SELECT rule_id, area, active, last_fired_date FROM alert_states")
+	if err != nil {
+		return nil, fmt.Errorf("db.Query() error: %v", err)
+	}
+	defer rows.Close()
+
+	states := map[StateKey]State{}
+	for rows.Next() {
+		var key StateKey
+		var state State
+		if err := rows.Scan(&key.RuleID, &key.Area, &state.Active, &state.LastFiredDate); err != nil {
+			return nil, fmt.Errorf("rows.Scan() error: %v", err)
+		}
+		states[key] = state
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows.Err() error: %v", err)
+	}
+	return states, nil
+}
+
+// saveStates は評価結果で発火状態を更新する
+func saveStates(ctx context.Context, q middleware.Querier, results []Result, states map[StateKey]State) error {
+	updatedAt := time.Now().UTC().Truncate(time.Second)
+	for _, result := range results {
+		key := StateKey{result.RuleID, result.Area}
+		current, exists := states[key]
+		next := State{Active: result.Triggered, LastFiredDate: current.LastFiredDate}
+		if result.Triggered && !current.Active {
+			next.LastFiredDate = result.Date
+		}
+		if exists && next == current {
+			continue
+		}
+
+		// 一度も発火していない場合の last_fired_date は NULL
+		var err error
+		if exists {
+			_, err = q.ExecContext(ctx, "UPDATE alert_states SET active = ?, last_fired_date = ?, updated_at = ? WHERE rule_id = ? AND area = ?", next.Active, next.LastFiredDate, updatedAt, key.RuleID, key.Area)
+		} else {
+			_, err = q.ExecContext(ctx, "INSERT INTO alert_states (rule_id, area, active, last_fired_date, updated_at) VALUES (?,?,?,?,?)", key.RuleID, key.Area, next.Active, next.LastFiredDate, updatedAt)
+		}
+		if err != nil {
+			return fmt.Errorf("save alert_states error: %v", err)
+		}
+	}
+	return nil
+}
+
+// EvaluateLatest は最新日付のデータでルールを評価し、新たに発火したアラートを返す
+func EvaluateLatest(ctx context.Context, txAdmin *middleware.TxAdmin) ([]Alert, error) {
+	var alerts []Alert
+	// 評価と発火状態の更新は同じトランザクションで行う
+	err := txAdmin.Transaction(ctx, func(ctx context.Context) error {
+		q := txAdmin.Querier(ctx)
+
+		rules, err := GetEnabledRules(ctx, q)
+		if err != nil {
+			return err
+		}
+		if len(rules) == 0 {
+			return nil
+		}
+
+		latestDate, err := patient.GetLatestDate(ctx, q)
+		if err != nil {
+			return err
+		}
+		patientDetails, err := patient.GetPatientDetailsByPeriod(ctx, q, date.LastDays(latestDate, 2*AverageDays))
+		if err != nil {
+			return err
+		}
+		averages := Averages(patientDetails, latestDate)
+
+		states, err := GetStates(ctx, q)
+		if err != nil {
+			return err
+		}
+		results := Evaluate(rules, averages)
+		alerts = Deduplicate(results, states)
+		return saveStates(ctx, q, results, states)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return alerts, nil
+}
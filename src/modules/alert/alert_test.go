@@ -0,0 +1,108 @@
+package alert
+
+import (
+	"corona-api/src/modules/date"
+	"corona-api/src/modules/patient"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+// twoWeeks は前週は毎日 previous 人、直近の週は毎日 current 人のデータ(最新日付は2023-01-14)
+func twoWeeks(area string, previous uint32, current uint32) []patient.Detail {
+	var patientDetails []patient.Detail
+	for _, d := range date.LastDays(date.MustParse("2023-01-14"), 14).Dates() {
+		value := previous
+		if !d.Before(date.MustParse("2023-01-08")) {
+			value = current
+		}
+		patientDetails = append(patientDetails, patient.Detail{Date: d, Area: area, Value: value, Country: patient.DefaultCountry})
+	}
+	return patientDetails
+}
+
+func TestAverages(t *testing.T) {
+	patientDetails := append(twoWeeks("東京都", 100, 150), twoWeeks("北海道", 0, 10)...)
+	// 期間外のデータは含めない
+	patientDetails = append(patientDetails, patient.Detail{Date: date.MustParse("20221231"), Area: "東京都", Value: 10000})
+
+	averages := Averages(patientDetails, date.MustParse("20230114"))
+	assert.Equal(t, []Average{
+		{Area: "北海道", Date: date.MustParse("20230114"), Average: 10, PreviousAverage: 0},
+		{Area: "東京都", Date: date.MustParse("20230114"), Average: 150, PreviousAverage: 100},
+	}, averages)
+
+	change, ok := averages[1].Change()
+	assert.True(t, ok)
+	assert.Equal(t, 50.0, change)
+	_, ok = averages[0].Change()
+	assert.False(t, ok)
+}
+
+func TestEvaluate(t *testing.T) {
+	averages := []Average{
+		{Area: "北海道", Date: date.MustParse("20230114"), Average: 10, PreviousAverage: 0},
+		{Area: "東京都", Date: date.MustParse("20230114"), Average: 150, PreviousAverage: 100},
+		{Area: "大阪府", Date: date.MustParse("20230114"), Average: 90, PreviousAverage: 100},
+	}
+	tests := []struct {
+		name string
+		rule Rule
+		want map[string]bool
+	}{
+		{
+			"week over week for all areas",
+			Rule{ID: 1, Kind: RuleKindWeekOverWeek, Threshold: 30, Enabled: true},
+			// 前週が0の場合は前週比を計算できないので発火しない
+			map[string]bool{"北海道": false, "東京都": true, "大阪府": false},
+		},
+		{
+			"week over week not exceeding threshold",
+			Rule{ID: 2, Kind: RuleKindWeekOverWeek, Threshold: 50, Enabled: true},
+			map[string]bool{"北海道": false, "東京都": false, "大阪府": false},
+		},
+		{
+			"level for one area",
+			Rule{ID: 3, Area: "大阪府", Kind: RuleKindLevel, Threshold: 90, Enabled: true},
+			map[string]bool{"大阪府": true},
+		},
+		{
+			"disabled rule",
+			Rule{ID: 4, Kind: RuleKindLevel, Threshold: 0, Enabled: false},
+			map[string]bool{},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got := map[string]bool{}
+			for _, result := range Evaluate([]Rule{tt.rule}, averages) {
+				assert.Equal(t, tt.rule.ID, result.RuleID)
+				got[result.Area] = result.Triggered
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestDeduplicate(t *testing.T) {
+	results := []Result{
+		{Alert: Alert{RuleID: 1, Area: "東京都"}, Triggered: true},
+		{Alert: Alert{RuleID: 1, Area: "大阪府"}, Triggered: true},
+		{Alert: Alert{RuleID: 1, Area: "北海道"}, Triggered: false},
+	}
+	states := map[StateKey]State{
+		// 発火中なので通知しない
+		{1, "東京都"}: {Active: true, LastFiredDate: date.MustParse("20230113")},
+		// 一度条件を外れたので再び通知する
+		{1, "大阪府"}: {Active: false, LastFiredDate: date.MustParse("20230101")},
+	}
+
+	alerts := Deduplicate(results, states)
+	assert.Equal(t, []Alert{{RuleID: 1, Area: "大阪府"}}, alerts)
+}
+
+func TestRule_Validate(t *testing.T) {
+	assert.NoError(t, Rule{Kind: RuleKindLevel}.Validate())
+	assert.Error(t, Rule{Kind: "unknown"}.Validate())
+}
@@ -0,0 +1,82 @@
+package analytics
+
+import (
+	"fmt"
+	"math"
+)
+
+// BacktestWindowStep は検証する予測の起点の間隔(日)
+const BacktestWindowStep = 7
+
+// BacktestResult は過去の期間で予測した誤差の評価
+type BacktestResult struct {
+	Model string `json:"model"`
+	// Windows は検証した予測の起点の数、Points は比べた予測の数
+	Windows int     `json:"windows"`
+	Points  int     `json:"points"`
+	MAE     float64 `json:"mae"`
+	RMSE    float64 `json:"rmse"`
+	// MAPE は実績が0の日を除いた平均絶対パーセント誤差(%)
+	MAPE *float64 `json:"mape"`
+	// MASE は同じ曜日の前週の値を予測とした場合の MAE との比(1より小さければ前週の値より良い)
+	MASE *float64 `json:"mase"`
+	// Coverage は実績が予測区間に入った割合
+	Coverage float64 `json:"coverage"`
+}
+
+// Backtest は系列の最後から BacktestWindowStep 日ずつ遡った最大 windows 個の起点で、
+// 起点より前の opts.Window 日間から予測した opts.Horizon 日間を実績と比べる
+func Backtest(series Series, opts ForecastOptions, windows int) (BacktestResult, error) {
+	if err := opts.validate(); err != nil {
+		return BacktestResult{}, err
+	}
+	res := BacktestResult{Model: opts.Model}
+	var absSum, sqSum, apeSum, naiveSum float64
+	var apeCount, covered int
+	for k := 0; k < windows; k++ {
+		// 起点(予測の最初の日の位置)
+		origin := series.Len() - opts.Horizon - k*BacktestWindowStep
+		if origin-opts.Window < 0 || origin-seasonLength < 0 {
+			break
+		}
+		train := Series{Start: series.Date(origin - opts.Window), Values: series.Values[origin-opts.Window : origin]}
+		points, err := Forecast(train, opts)
+		if err != nil {
+			return BacktestResult{}, err
+		}
+		res.Windows++
+		for h, p := range points {
+			actual := series.Values[origin+h]
+			e := p.Value - actual
+			absSum += math.Abs(e)
+			sqSum += e * e
+			if actual != 0 {
+				apeSum += math.Abs(e) / actual
+				apeCount++
+			}
+			if p.Lower <= actual && actual <= p.Upper {
+				covered++
+			}
+			naive := series.Values[origin-seasonLength+h%seasonLength]
+			naiveSum += math.Abs(naive - actual)
+			res.Points++
+		}
+	}
+	if res.Windows == 0 {
+		return BacktestResult{}, fmt.Errorf("not enough data for backtest: %v days", series.Len())
+	}
+
+	n := float64(res.Points)
+	res.MAE = absSum / n
+	res.RMSE = math.Sqrt(sqSum / n)
+	res.Coverage = float64(covered) / n
+	if apeCount > 0 {
+		mape := apeSum / float64(apeCount) * 100
+		res.MAPE = &mape
+	}
+	if naiveSum > 0 {
+		mase := absSum / naiveSum
+		res.MASE = &mase
+	}
+	return res, nil
+}
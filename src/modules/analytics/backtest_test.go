@@ -0,0 +1,55 @@
+package analytics
+
+import (
+	"corona-api/src/modules/date"
+	"github.com/stretchr/testify/assert"
+	"math"
+	"math/rand"
+	"testing"
+)
+
+func TestBacktest(t *testing.T) {
+	values := make([]float64, 120)
+	for i := range values {
+		values[i] = weeklyGrowth(i, 0.02)
+	}
+	series := Series{Start: date.MustParse("2022-07-01"), Values: values}
+	opts := DefaultForecastOptions()
+	opts.Model = ModelLogLinear
+
+	res, err := Backtest(series, opts, 8)
+	assert.NoError(t, err)
+	assert.Equal(t, ModelLogLinear, res.Model)
+	assert.Equal(t, 8, res.Windows)
+	assert.Equal(t, 8*opts.Horizon, res.Points)
+	// 対数の線形トレンドと曜日の効果に当てはまる系列なので誤差はほぼ0
+	assert.True(t, *res.MAPE < 0.01)
+	assert.True(t, *res.MASE < 1e-3)
+	assert.True(t, res.RMSE >= res.MAE)
+
+	// 遡れる起点の数だけ検証する(120日から学習の42日と予測の14日を除いた64日で10個)
+	res, err = Backtest(series, opts, 52)
+	assert.NoError(t, err)
+	assert.Equal(t, 10, res.Windows)
+
+	_, err = Backtest(Series{Start: series.Start, Values: values[:50]}, opts, 8)
+	assert.Error(t, err)
+}
+
+func TestBacktest_Coverage(t *testing.T) {
+	// 対数の誤差が正規分布の系列では、実績がおよそ予測区間の水準の割合で区間に入る
+	r := rand.New(rand.NewSource(1))
+	values := make([]float64, 400)
+	for i := range values {
+		values[i] = weeklyGrowth(i, 0.002) * math.Exp(0.1*r.NormFloat64())
+	}
+	series := Series{Start: date.MustParse("2021-07-01"), Values: values}
+	for _, model := range Models {
+		opts := DefaultForecastOptions()
+		opts.Model = model
+		res, err := Backtest(series, opts, 40)
+		assert.NoError(t, err)
+		assert.InDelta(t, opts.Level, res.Coverage, 0.1, model)
+		assert.True(t, *res.MASE < 1, model)
+	}
+}
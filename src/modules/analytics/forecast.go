@@ -0,0 +1,318 @@
+package analytics
+
+import (
+	"corona-api/src/modules/date"
+	"fmt"
+	"math"
+)
+
+// 予測モデル
+const (
+	// ModelLogLinear は対数の線形トレンドと曜日の効果の回帰
+	ModelLogLinear = "log_linear"
+	// ModelHoltWinters は対数の加法型 Holt-Winters(週の季節性)
+	ModelHoltWinters = "holt_winters"
+)
+
+// Models は指定できる予測モデル
+var Models = []string{ModelLogLinear, ModelHoltWinters}
+
+const (
+	DefaultForecastModel   = ModelHoltWinters
+	DefaultForecastHorizon = 14
+	DefaultForecastWindow  = 42
+	DefaultForecastLevel   = 0.95
+	// MinForecastWindow は学習に使う日数の下限(Holt-Winters の初期値に2週間使う)
+	MinForecastWindow = 21
+)
+
+// seasonLength は季節性の周期(曜日)
+const seasonLength = 7
+
+// ForecastOptions は予測の設定
+type ForecastOptions struct {
+	Model string `json:"model"`
+	// Horizon は予測する日数
+	Horizon int `json:"horizon"`
+	// Window は学習に使う最新の日数
+	Window int `json:"window"`
+	// Level は予測区間の水準
+	Level float64 `json:"level"`
+}
+
+func DefaultForecastOptions() ForecastOptions {
+	return ForecastOptions{
+		Model:   DefaultForecastModel,
+		Horizon: DefaultForecastHorizon,
+		Window:  DefaultForecastWindow,
+		Level:   DefaultForecastLevel,
+	}
+}
+
+// ForecastPoint は日ごとの予測。Value は予測分布の中央値、Lower, Upper は予測区間
+type ForecastPoint struct {
+	Date  date.Date `json:"date"`
+	Value float64   `json:"value"`
+	Lower float64   `json:"lower"`
+	Upper float64   `json:"upper"`
+}
+
+func (o ForecastOptions) validate() error {
+	valid := false
+	for _, model := range Models {
+		if o.Model == model {
+			valid = true
+		}
+	}
+	if !valid {
+		return fmt.Errorf("unknown model: %v", o.Model)
+	}
+	if o.Horizon < 1 {
+		return fmt.Errorf("invalid horizon: %v", o.Horizon)
+	}
+	if o.Window < MinForecastWindow {
+		return fmt.Errorf("invalid window: %v", o.Window)
+	}
+	if o.Level <= 0 || o.Level >= 1 {
+		return fmt.Errorf("invalid level: %v", o.Level)
+	}
+	return nil
+}
+
+// Forecast は系列の最新の Window 日間から、最後の日の翌日から Horizon 日間を予測する
+// 曜日による増減を比で扱えるように log(1+x) に当てはめ、予測区間は対数の正規分布から求める
+func Forecast(series Series, opts ForecastOptions) ([]ForecastPoint, error) {
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+	if series.Len() < opts.Window {
+		return nil, fmt.Errorf("not enough data: %v days, window: %v", series.Len(), opts.Window)
+	}
+	logs := make([]float64, opts.Window)
+	for i, v := range series.Values[series.Len()-opts.Window:] {
+		logs[i] = math.Log1p(math.Max(v, 0))
+	}
+
+	var means, sds []float64
+	var err error
+	switch opts.Model {
+	case ModelLogLinear:
+		means, sds, err = logLinearForecast(logs, opts.Horizon)
+	case ModelHoltWinters:
+		means, sds = holtWintersForecast(logs, opts.Horizon)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	z := math.Sqrt2 * math.Erfinv(opts.Level)
+	last := series.Date(series.Len() - 1)
+	points := make([]ForecastPoint, opts.Horizon)
+	for h := range points {
+		points[h] = ForecastPoint{
+			Date:  last.AddDays(h + 1),
+			Value: math.Max(math.Expm1(means[h]), 0),
+			Lower: math.Max(math.Expm1(means[h]-z*sds[h]), 0),
+			Upper: math.Max(math.Expm1(means[h]+z*sds[h]), 0),
+		}
+	}
+	return points, nil
+}
+
+// logLinearForecast は切片、傾きと曜日のダミー変数(6個)の最小二乗法で当てはめ、
+// 予測値と予測の標準偏差 s√(1 + x0'(X'X)^-1 x0) を返す
+func logLinearForecast(values []float64, horizon int) ([]float64, []float64, error) {
+	n := len(values)
+	const k = 2 + seasonLength - 1
+	row := func(t int) []float64 {
+		x := make([]float64, k)
+		x[0] = 1
+		x[1] = float64(t)
+		if d := t % seasonLength; d > 0 {
+			x[1+d] = 1
+		}
+		return x
+	}
+
+	xtx := make([][]float64, k)
+	for i := range xtx {
+		xtx[i] = make([]float64, k)
+	}
+	xty := make([]float64, k)
+	for t, y := range values {
+		x := row(t)
+		for i := 0; i < k; i++ {
+			xty[i] += x[i] * y
+			for j := 0; j < k; j++ {
+				xtx[i][j] += x[i] * x[j]
+			}
+		}
+	}
+	inv, err := invert(xtx)
+	if err != nil {
+		return nil, nil, err
+	}
+	beta := make([]float64, k)
+	for i := range beta {
+		for j := range xty {
+			beta[i] += inv[i][j] * xty[j]
+		}
+	}
+
+	var sse float64
+	for t, y := range values {
+		e := y - dot(beta, row(t))
+		sse += e * e
+	}
+	s2 := sse / float64(n-k)
+
+	means := make([]float64, horizon)
+	sds := make([]float64, horizon)
+	for h := range means {
+		x := row(n + h)
+		var leverage float64
+		for i := 0; i < k; i++ {
+			for j := 0; j < k; j++ {
+				leverage += x[i] * inv[i][j] * x[j]
+			}
+		}
+		means[h] = dot(beta, x)
+		sds[h] = math.Sqrt(s2 * (1 + leverage))
+	}
+	return means, sds, nil
+}
+
+// holtWintersGrid は Holt-Winters の平滑化パラメーターの候補
+var holtWintersGrid = struct {
+	alpha []float64
+	beta  []float64
+	gamma []float64
+}{
+	alpha: []float64{0.1, 0.2, 0.3, 0.4, 0.5, 0.6, 0.7, 0.8, 0.9},
+	beta:  []float64{0.01, 0.02, 0.05, 0.1, 0.2},
+	gamma: []float64{0.01, 0.05, 0.1, 0.2, 0.3},
+}
+
+// holtWinters は加法型 Holt-Winters(誤差修正形式、ETS(A,A,A))の状態
+type holtWinters struct {
+	alpha, beta, gamma float64
+	level, trend       float64
+	season             [seasonLength]float64
+	sse                float64
+}
+
+// fitHoltWinters は最初の2週間で初期値を決め、1期先予測の誤差を更新しながら最後の日まで進める
+func fitHoltWinters(values []float64, alpha, beta, gamma float64) holtWinters {
+	hw := holtWinters{alpha: alpha, beta: beta, gamma: gamma}
+	first := mean(values[:seasonLength])
+	second := mean(values[seasonLength : 2*seasonLength])
+	hw.level = first
+	hw.trend = (second - first) / seasonLength
+	for i := 0; i < seasonLength; i++ {
+		hw.season[i] = values[i] - first
+	}
+	for t, y := range values {
+		s := t % seasonLength
+		e := y - (hw.level + hw.trend + hw.season[s])
+		hw.sse += e * e
+		hw.level += hw.trend + alpha*e
+		hw.trend += beta * e
+		hw.season[s] += gamma * e
+	}
+	return hw
+}
+
+// holtWintersForecast は1期先予測の二乗誤差の合計が最小になる平滑化パラメーターを格子探索で選び、
+// 予測値と予測の標準偏差 σ√(1 + Σ(α + βj + γ[j が周期の倍数])²) を返す(Hyndman et al. (2008) の class 1)
+func holtWintersForecast(values []float64, horizon int) ([]float64, []float64) {
+	var best holtWinters
+	found := false
+	for _, alpha := range holtWintersGrid.alpha {
+		for _, beta := range holtWintersGrid.beta {
+			for _, gamma := range holtWintersGrid.gamma {
+				// 予測が発散しない範囲(0 < β < α, 0 < γ < 1 - α)
+				if beta >= alpha || gamma >= 1-alpha {
+					continue
+				}
+				hw := fitHoltWinters(values, alpha, beta, gamma)
+				if !found || hw.sse < best.sse {
+					best, found = hw, true
+				}
+			}
+		}
+	}
+
+	n := len(values)
+	// 平滑化パラメーターの数だけ自由度を減らす
+	sigma2 := best.sse / float64(n-3)
+	means := make([]float64, horizon)
+	sds := make([]float64, horizon)
+	var acc float64
+	for h := 1; h <= horizon; h++ {
+		means[h-1] = best.level + float64(h)*best.trend + best.season[(n-1+h)%seasonLength]
+		sds[h-1] = math.Sqrt(sigma2 * (1 + acc))
+		c := best.alpha + best.beta*float64(h)
+		if h%seasonLength == 0 {
+			c += best.gamma
+		}
+		acc += c * c
+	}
+	return means, sds
+}
+
+// invert はガウス・ジョルダン法(部分ピボット選択)で逆行列を求める
+func invert(matrix [][]float64) ([][]float64, error) {
+	n := len(matrix)
+	a := make([][]float64, n)
+	for i := range a {
+		a[i] = make([]float64, 2*n)
+		copy(a[i], matrix[i])
+		a[i][n+i] = 1
+	}
+	for col := 0; col < n; col++ {
+		pivot := col
+		for r := col + 1; r < n; r++ {
+			if math.Abs(a[r][col]) > math.Abs(a[pivot][col]) {
+				pivot = r
+			}
+		}
+		if math.Abs(a[pivot][col]) < 1e-12 {
+			return nil, fmt.Errorf("singular matrix")
+		}
+		a[col], a[pivot] = a[pivot], a[col]
+		p := a[col][col]
+		for j := range a[col] {
+			a[col][j] /= p
+		}
+		for r := 0; r < n; r++ {
+			if r == col || a[r][col] == 0 {
+				continue
+			}
+			f := a[r][col]
+			for j := range a[r] {
+				a[r][j] -= f * a[col][j]
+			}
+		}
+	}
+	inv := make([][]float64, n)
+	for i := range inv {
+		inv[i] = a[i][n:]
+	}
+	return inv, nil
+}
+
+func dot(x []float64, y []float64) float64 {
+	var sum float64
+	for i := range x {
+		sum += x[i] * y[i]
+	}
+	return sum
+}
+
+func mean(values []float64) float64 {
+	var sum float64
+	for _, v := range values {
+		sum += v
+	}
+	return sum / float64(len(values))
+}
@@ -0,0 +1,96 @@
+package analytics
+
+import (
+	"corona-api/src/modules/date"
+	"github.com/stretchr/testify/assert"
+	"math"
+	"math/rand"
+	"testing"
+)
+
+// weeklyGrowth は増加率 r で増え、月曜(4日目)が半分になる系列の i 日目の値
+func weeklyGrowth(i int, r float64) float64 {
+	v := 1000 * math.Exp(r*float64(i))
+	if i%7 == 3 {
+		v *= 0.5
+	}
+	return v
+}
+
+func TestForecast(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		model string
+		delta float64
+	}{
+		// 対数の線形トレンドと曜日の効果に当てはまる系列はほぼ一致する(log(1+x) に当てはめるため)
+		{model: ModelLogLinear, delta: 1e-3},
+		{model: ModelHoltWinters, delta: 0.05},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.model, func(t *testing.T) {
+			t.Parallel()
+			values := make([]float64, 60)
+			for i := range values {
+				values[i] = weeklyGrowth(i, 0.03)
+			}
+			opts := DefaultForecastOptions()
+			opts.Model = tt.model
+			start := date.MustParse("2022-07-01")
+			points, err := Forecast(Series{Start: start, Values: values}, opts)
+			assert.NoError(t, err)
+			assert.Len(t, points, opts.Horizon)
+			for h, p := range points {
+				assert.Equal(t, start.AddDays(60+h), p.Date)
+				want := weeklyGrowth(60+h, 0.03)
+				assert.InDelta(t, want, p.Value, want*tt.delta, p.Date.String())
+				assert.True(t, p.Lower <= p.Value && p.Value <= p.Upper, p.Date.String())
+			}
+		})
+	}
+}
+
+func TestForecast_Intervals(t *testing.T) {
+	// 予測区間は先の日ほど広い
+	r := rand.New(rand.NewSource(1))
+	values := make([]float64, 60)
+	for i := range values {
+		values[i] = weeklyGrowth(i, 0.01) * math.Exp(0.1*r.NormFloat64())
+	}
+	for _, model := range Models {
+		opts := DefaultForecastOptions()
+		opts.Model = model
+		points, err := Forecast(Series{Start: date.MustParse("2022-07-01"), Values: values}, opts)
+		assert.NoError(t, err)
+		first, last := points[0], points[len(points)-1]
+		assert.True(t, first.Upper/first.Lower < last.Upper/last.Lower, model)
+		assert.True(t, first.Lower > 0, model)
+	}
+}
+
+func TestForecast_InvalidOptions(t *testing.T) {
+	series := Series{Start: date.MustParse("2022-07-01"), Values: make([]float64, 60)}
+	for _, modify := range []func(*ForecastOptions){
+		func(o *ForecastOptions) { o.Model = "arima" },
+		func(o *ForecastOptions) { o.Horizon = 0 },
+		func(o *ForecastOptions) { o.Window = 14 },
+		func(o *ForecastOptions) { o.Window = 61 },
+		func(o *ForecastOptions) { o.Level = 1 },
+	} {
+		opts := DefaultForecastOptions()
+		modify(&opts)
+		_, err := Forecast(series, opts)
+		assert.Error(t, err)
+	}
+}
+
+func TestInvert(t *testing.T) {
+	inv, err := invert([][]float64{{4, 7}, {2, 6}})
+	assert.NoError(t, err)
+	assert.InDeltaSlice(t, []float64{0.6, -0.7}, inv[0], 1e-12)
+	assert.InDeltaSlice(t, []float64{-0.2, 0.4}, inv[1], 1e-12)
+
+	_, err = invert([][]float64{{1, 2}, {2, 4}})
+	assert.Error(t, err)
+}
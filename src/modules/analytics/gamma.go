@@ -0,0 +1,95 @@
+package analytics
+
+import (
+	"math"
+)
+
+const (
+	gammaEpsilon       = 1e-15
+	gammaMaxIterations = 100000
+	gammaTiny          = 1e-300
+)
+
+// gammaCDF はガンマ分布(形状 shape, 尺度 scale)の累積分布関数
+func gammaCDF(x float64, shape float64, scale float64) float64 {
+	if x <= 0 {
+		return 0
+	}
+	return regularizedGammaP(shape, x/scale)
+}
+
+// gammaQuantile はガンマ分布の p 分位点(二分法で gammaCDF の逆関数を求める)
+func gammaQuantile(p float64, shape float64, scale float64) float64 {
+	if p <= 0 {
+		return 0
+	}
+	if p >= 1 {
+		return math.Inf(1)
+	}
+	lo, hi := 0.0, shape+10*math.Sqrt(shape)+10
+	for regularizedGammaP(shape, hi) < p {
+		lo, hi = hi, hi*2
+	}
+	for i := 0; i < 200 && hi-lo > 1e-12*hi; i++ {
+		mid := (lo + hi) / 2
+		if regularizedGammaP(shape, mid) < p {
+			lo = mid
+		} else {
+			hi = mid
+		}
+	}
+	return (lo + hi) / 2 * scale
+}
+
+// regularizedGammaP は正則化された下側不完全ガンマ関数 P(a, x)
+// x < a+1 は級数展開、それ以外は連分数(Numerical Recipes の gammp)で求める
+func regularizedGammaP(a float64, x float64) float64 {
+	if x <= 0 {
+		return 0
+	}
+	lgamma, _ := math.Lgamma(a)
+	prefix := -x + a*math.Log(x) - lgamma
+	if x < a+1 {
+		ap, sum := a, 1/a
+		term := sum
+		for i := 0; i < gammaMaxIterations; i++ {
+			ap++
+			term *= x / ap
+			sum += term
+			if math.Abs(term) < math.Abs(sum)*gammaEpsilon {
+				break
+			}
+		}
+		return sum * math.Exp(prefix)
+	}
+
+	// 上側 Q(a, x) を修正 Lentz 法で求める
+	b := x + 1 - a
+	c := 1 / gammaTiny
+	d := 1 / b
+	h := d
+	for i := 1; i < gammaMaxIterations; i++ {
+		an := -float64(i) * (float64(i) - a)
+		b += 2
+		d = an*d + b
+		if math.Abs(d) < gammaTiny {
+			d = gammaTiny
+		}
+		c = b + an/c
+		if math.Abs(c) < gammaTiny {
+			c = gammaTiny
+		}
+		d = 1 / d
+		delta := d * c
+		h *= delta
+		if math.Abs(delta-1) < gammaEpsilon {
+			break
+		}
+	}
+	return 1 - math.Exp(prefix)*h
+}
+
+// gammaShapeScale は平均と標準偏差からガンマ分布の形状と尺度を求める
+func gammaShapeScale(mean float64, sd float64) (float64, float64) {
+	return (mean * mean) / (sd * sd), (sd * sd) / mean
+}
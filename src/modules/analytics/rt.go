@@ -0,0 +1,166 @@
+package analytics
+
+import (
+	"corona-api/src/modules/date"
+	"errors"
+	"fmt"
+	"math"
+)
+
+// MethodCori は Cori et al. (2013) の実効再生産数の推定方法(R の EpiEstim と同じ)
+const MethodCori = "cori"
+
+const (
+	// 新型コロナウイルスの発症間隔(Nishiura et al. 2020)
+	DefaultSerialIntervalMean = 4.7
+	DefaultSerialIntervalSD   = 2.9
+	DefaultRtWindow           = 7
+	// EpiEstim の既定の事前分布(平均5, 標準偏差5のガンマ分布)
+	DefaultRtPriorMean     = 5
+	DefaultRtPriorSD       = 5
+	DefaultCredibleLevel   = 0.95
+	serialIntervalMaxDays  = 60
+	serialIntervalCoverage = 0.9999
+)
+
+// SerialInterval は発症間隔の分布。Distribution を指定しなければ平均と標準偏差のガンマ分布を離散化する
+type SerialInterval struct {
+	Mean float64 `json:"mean"`
+	SD   float64 `json:"sd"`
+	// Distribution[k] は発症間隔が k 日の確率(Distribution[0] は0)
+	Distribution []float64 `json:"-"`
+}
+
+// Discretize は発症間隔の離散分布(合計が1)を返す
+// ガンマ分布は EpiEstim の discr_si と同じく1日ずらしたガンマ分布を区間で平均する(平均が Mean のまま保たれる)
+func (si SerialInterval) Discretize() ([]float64, error) {
+	if len(si.Distribution) > 0 {
+		return normalizeDistribution(si.Distribution)
+	}
+	if si.Mean <= 1 || si.SD <= 0 {
+		return nil, fmt.Errorf("invalid serial interval: mean: %v, sd: %v", si.Mean, si.SD)
+	}
+	shape := math.Pow((si.Mean-1)/si.SD, 2)
+	scale := si.SD * si.SD / (si.Mean - 1)
+	cdf := func(k float64, shape float64) float64 {
+		return gammaCDF(k, shape, scale)
+	}
+
+	distribution := []float64{0}
+	var total float64
+	for k := 1.0; k <= serialIntervalMaxDays && total < serialIntervalCoverage; k++ {
+		w := k*cdf(k, shape) + (k-2)*cdf(k-2, shape) - 2*(k-1)*cdf(k-1, shape)
+		w += shape * scale * (2*cdf(k-1, shape+1) - cdf(k-2, shape+1) - cdf(k, shape+1))
+		w = math.Max(0, w)
+		distribution = append(distribution, w)
+		total += w
+	}
+	return normalizeDistribution(distribution)
+}
+
+func normalizeDistribution(distribution []float64) ([]float64, error) {
+	if distribution[0] != 0 {
+		return nil, errors.New("invalid serial interval: probability of 0 days must be 0")
+	}
+	var total float64
+	for _, w := range distribution {
+		if w < 0 {
+			return nil, errors.New("invalid serial interval: negative probability")
+		}
+		total += w
+	}
+	if total == 0 {
+		return nil, errors.New("invalid serial interval: empty distribution")
+	}
+	normalized := make([]float64, len(distribution))
+	for i, w := range distribution {
+		normalized[i] = w / total
+	}
+	return normalized, nil
+}
+
+// RtOptions は実効再生産数の推定の設定
+type RtOptions struct {
+	SerialInterval SerialInterval
+	// Window は推定に使う日数(その日までの Window 日間で Rt が一定と仮定する)
+	Window        int
+	PriorMean     float64
+	PriorSD       float64
+	CredibleLevel float64
+}
+
+func DefaultRtOptions() RtOptions {
+	return RtOptions{
+		SerialInterval: SerialInterval{Mean: DefaultSerialIntervalMean, SD: DefaultSerialIntervalSD},
+		Window:         DefaultRtWindow,
+		PriorMean:      DefaultRtPriorMean,
+		PriorSD:        DefaultRtPriorSD,
+		CredibleLevel:  DefaultCredibleLevel,
+	}
+}
+
+// RtEstimate は Date までの Window 日間の実効再生産数の事後分布の要約
+type RtEstimate struct {
+	Date   date.Date `json:"date"`
+	Mean   float64   `json:"mean"`
+	SD     float64   `json:"sd"`
+	Median float64   `json:"median"`
+	// Lower, Upper は信用区間(CredibleLevel)の下限と上限
+	Lower float64 `json:"lower"`
+	Upper float64 `json:"upper"`
+	// Cases は Window 日間の感染者数(少ないと推定が不安定になる)
+	Cases float64 `json:"cases"`
+}
+
+// EstimateRt は Cori の方法で日ごとの実効再生産数を推定する
+// 感染力 Λt = Σ I(t-s) w(s) とすると、事後分布はガンマ分布(形状 a + ΣI, 尺度 1 / (1/b + ΣΛ))になる
+// 系列の最初の Window 日間と、感染力が0の期間は推定しない
+func EstimateRt(series Series, opts RtOptions) ([]RtEstimate, error) {
+	if opts.Window < 1 {
+		return nil, fmt.Errorf("invalid window: %v", opts.Window)
+	}
+	if opts.PriorMean <= 0 || opts.PriorSD <= 0 {
+		return nil, fmt.Errorf("invalid prior: mean: %v, sd: %v", opts.PriorMean, opts.PriorSD)
+	}
+	if opts.CredibleLevel <= 0 || opts.CredibleLevel >= 1 {
+		return nil, fmt.Errorf("invalid credible level: %v", opts.CredibleLevel)
+	}
+	w, err := opts.SerialInterval.Discretize()
+	if err != nil {
+		return nil, err
+	}
+
+	incidence := series.Values
+	infectivity := make([]float64, len(incidence))
+	for t := range incidence {
+		for s := 1; s < len(w) && s <= t; s++ {
+			infectivity[t] += incidence[t-s] * w[s]
+		}
+	}
+
+	priorShape, priorScale := gammaShapeScale(opts.PriorMean, opts.PriorSD)
+	tail := (1 - opts.CredibleLevel) / 2
+	estimates := []RtEstimate{}
+	for t := opts.Window; t < len(incidence); t++ {
+		var cases, lambda float64
+		for k := t - opts.Window + 1; k <= t; k++ {
+			cases += incidence[k]
+			lambda += infectivity[k]
+		}
+		if lambda == 0 {
+			continue
+		}
+		shape := priorShape + cases
+		scale := 1 / (1/priorScale + lambda)
+		estimates = append(estimates, RtEstimate{
+			Date:   series.Date(t),
+			Mean:   shape * scale,
+			SD:     math.Sqrt(shape) * scale,
+			Median: gammaQuantile(0.5, shape, scale),
+			Lower:  gammaQuantile(tail, shape, scale),
+			Upper:  gammaQuantile(1-tail, shape, scale),
+			Cases:  cases,
+		})
+	}
+	return estimates, nil
+}
@@ -0,0 +1,179 @@
+package analytics
+
+import (
+	"corona-api/src/modules/date"
+	"encoding/csv"
+	"github.com/stretchr/testify/assert"
+	"math"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+)
+
+func TestGammaQuantile(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name  string
+		p     float64
+		shape float64
+		scale float64
+		want  float64
+	}{
+		// 指数分布
+		{name: "exponential median", p: 0.5, shape: 1, scale: 1, want: math.Ln2},
+		{name: "exponential 2.5%", p: 0.025, shape: 1, scale: 1, want: -math.Log(0.975)},
+		{name: "exponential 97.5%", p: 0.975, shape: 1, scale: 1, want: -math.Log(0.025)},
+		// 自由度10のカイ二乗分布
+		{name: "chi-squared 2.5%", p: 0.025, shape: 5, scale: 2, want: 3.246973},
+		{name: "chi-squared 97.5%", p: 0.975, shape: 5, scale: 2, want: 20.483177},
+		// 正規分布で近似できる形状の大きい分布(平均 ± 1.96 標準偏差)
+		{name: "large shape", p: 0.975, shape: 1e6, scale: 1e-6, want: 1 + 1.959964e-3 + 1.28e-6},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.InDelta(t, tt.want, gammaQuantile(tt.p, tt.shape, tt.scale), 1e-5)
+		})
+	}
+}
+
+func TestSerialInterval_Discretize(t *testing.T) {
+	w, err := SerialInterval{Mean: DefaultSerialIntervalMean, SD: DefaultSerialIntervalSD}.Discretize()
+	assert.NoError(t, err)
+	assert.Equal(t, 0.0, w[0])
+
+	// 離散化しても平均は変わらない
+	var total, mean float64
+	for k, p := range w {
+		total += p
+		mean += float64(k) * p
+	}
+	assert.InDelta(t, 1, total, 1e-9)
+	assert.InDelta(t, DefaultSerialIntervalMean, mean, 1e-2)
+
+	_, err = SerialInterval{Mean: 1, SD: 1}.Discretize()
+	assert.Error(t, err)
+	_, err = SerialInterval{Distribution: []float64{0.5, 0.5}}.Discretize()
+	assert.Error(t, err)
+}
+
+func TestEstimateRt(t *testing.T) {
+	// 手計算できる系列: 発症間隔は1日と2日が半々、事前分布は形状1、尺度5
+	opts := RtOptions{
+		SerialInterval: SerialInterval{Distribution: []float64{0, 1, 1}},
+		Window:         2,
+		PriorMean:      5,
+		PriorSD:        5,
+		CredibleLevel:  0.95,
+	}
+	series := Series{Start: date.MustParse("2023-01-01"), Values: []float64{10, 10, 20, 40}}
+	estimates, err := EstimateRt(series, opts)
+	assert.NoError(t, err)
+	assert.Len(t, estimates, 2)
+
+	// 1月3日: 感染者数 10+20、感染力 5+10
+	assert.Equal(t, date.MustParse("2023-01-03"), estimates[0].Date)
+	assert.Equal(t, 30.0, estimates[0].Cases)
+	assert.InDelta(t, 31/15.2, estimates[0].Mean, 1e-9)
+	assert.InDelta(t, math.Sqrt(31)/15.2, estimates[0].SD, 1e-9)
+	// 1月4日: 感染者数 20+40、感染力 10+15
+	assert.InDelta(t, 61/25.2, estimates[1].Mean, 1e-9)
+	assert.True(t, estimates[1].Lower < estimates[1].Median && estimates[1].Median < estimates[1].Upper)
+}
+
+func TestEstimateRt_ExponentialGrowth(t *testing.T) {
+	// 増加率 r で指数関数的に増える系列では Rt = 1 / Σ w(s) e^(-rs)(Wallinga & Lipsitch 2007)
+	const r = 0.1
+	opts := DefaultRtOptions()
+	w, err := opts.SerialInterval.Discretize()
+	assert.NoError(t, err)
+	var m float64
+	for s, p := range w {
+		m += p * math.Exp(-r*float64(s))
+	}
+	want := 1 / m
+
+	values := make([]float64, 120)
+	for i := range values {
+		values[i] = 100 * math.Exp(r*float64(i))
+	}
+	estimates, err := EstimateRt(Series{Start: date.MustParse("2023-01-01"), Values: values}, opts)
+	assert.NoError(t, err)
+	assert.Len(t, estimates, 120-opts.Window)
+
+	// 発症間隔の分布より後は理論値と一致する
+	for _, e := range estimates[len(w):] {
+		assert.InDelta(t, want, e.Mean, want*1e-3, e.Date.String())
+		assert.True(t, e.Lower < want && want < e.Upper, e.Date.String())
+	}
+}
+
+func TestEstimateRt_Constant(t *testing.T) {
+	values := make([]float64, 90)
+	for i := range values {
+		values[i] = 500
+	}
+	estimates, err := EstimateRt(Series{Start: date.MustParse("2023-01-01"), Values: values}, DefaultRtOptions())
+	assert.NoError(t, err)
+	last := estimates[len(estimates)-1]
+	assert.InDelta(t, 1, last.Mean, 1e-3)
+}
+
+func TestEstimateRt_InvalidOptions(t *testing.T) {
+	series := Series{Start: date.MustParse("2023-01-01"), Values: []float64{1, 2, 3}}
+	for _, modify := range []func(*RtOptions){
+		func(o *RtOptions) { o.Window = 0 },
+		func(o *RtOptions) { o.CredibleLevel = 1 },
+		func(o *RtOptions) { o.PriorSD = 0 },
+		func(o *RtOptions) { o.SerialInterval.Mean = 0.5 },
+	} {
+		opts := DefaultRtOptions()
+		modify(&opts)
+		_, err := EstimateRt(series, opts)
+		assert.Error(t, err)
+	}
+}
+
+func TestEstimateRt_EpiEstim(t *testing.T) {
+	// testdata/epiestim_parametric_si.csv は EpiEstim の estimate_R(parametric_si)と同じ計算の参照値
+	f, err := os.Open(filepath.Join("testdata", "epiestim_parametric_si.csv"))
+	assert.NoError(t, err)
+	defer f.Close()
+	r := csv.NewReader(f)
+	r.Comment = '#'
+	records, err := r.ReadAll()
+	assert.NoError(t, err)
+	records = records[1:]
+
+	incidence := []float64{3, 5, 4, 8, 10, 9, 14, 18, 17, 25, 30, 28, 0, 41, 45, 52, 49, 60, 55, 51, 47, 40, 38, 30}
+	start := date.MustParse("2023-01-01")
+	estimates, err := EstimateRt(Series{Start: start, Values: incidence}, DefaultRtOptions())
+	assert.NoError(t, err)
+	assert.Len(t, estimates, len(records))
+
+	for i, record := range records {
+		var want [7]float64
+		for j := range want {
+			want[j], err = strconv.ParseFloat(record[j], 64)
+			assert.NoError(t, err)
+		}
+		e := estimates[i]
+		// t_end は1日目を1とした窓の最後の日
+		assert.Equal(t, start.AddDays(int(want[1])-1), e.Date)
+		// 発症間隔の分布を確率 0.9999 までで打ち切って正規化する分(1e-4 程度)だけずれる
+		for _, v := range []struct {
+			name      string
+			want, got float64
+		}{
+			{"mean", want[2], e.Mean},
+			{"sd", want[3], e.SD},
+			{"lower", want[4], e.Lower},
+			{"median", want[5], e.Median},
+			{"upper", want[6], e.Upper},
+		} {
+			assert.InDelta(t, v.want, v.got, v.want*2e-4, "%v: %v", e.Date, v.name)
+		}
+	}
+}
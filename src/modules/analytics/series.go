@@ -0,0 +1,47 @@
+package analytics
+
+import (
+	"corona-api/src/modules/date"
+	"corona-api/src/modules/patient"
+)
+
+// Series は Start から1日ごとの値(日ごとの感染者数)
+type Series struct {
+	Start  date.Date
+	Values []float64
+}
+
+// NewSeries は1つの都道府県のデータを日付順の系列にする。データが無い日は0にする
+func NewSeries(details []patient.Detail) Series {
+	if len(details) == 0 {
+		return Series{}
+	}
+	start, end := details[0].Date, details[0].Date
+	for _, pd := range details {
+		if pd.Date.Before(start) {
+			start = pd.Date
+		}
+		if pd.Date.After(end) {
+			end = pd.Date
+		}
+	}
+	values := make([]float64, end.Sub(start)+1)
+	for _, pd := range details {
+		values[pd.Date.Sub(start)] += float64(pd.Value)
+	}
+	return Series{Start: start, Values: values}
+}
+
+func (s Series) Len() int {
+	return len(s.Values)
+}
+
+// Date は i 番目の値の日付
+func (s Series) Date(i int) date.Date {
+	return s.Start.AddDays(i)
+}
+
+// Range は系列の期間
+func (s Series) Range() date.Range {
+	return date.Range{Start: s.Start, End: s.Date(s.Len() - 1)}
+}
@@ -0,0 +1,200 @@
+package analytics
+
+import (
+	"corona-api/src/modules/date"
+	"fmt"
+	"math"
+	"sort"
+)
+
+const (
+	DefaultSmoothingWindow = 7
+	DefaultMinProminence   = 0.5
+	DefaultMinDistance     = 30
+	DefaultMinPeak         = 1
+)
+
+// WaveOptions は流行の波の検出の設定
+type WaveOptions struct {
+	// SmoothingWindow は平滑化(中心移動平均)の日数
+	SmoothingWindow int `json:"smoothing_window"`
+	// MinProminence はピークの高さに対するプロミネンス(両側の谷のうち高い方からの高さ)の割合の下限(0〜1)
+	// 流行の規模が違っても同じ基準で判定できるようにピークの高さとの比にする
+	MinProminence float64 `json:"min_prominence"`
+	// MinDistance はピークの間隔の下限(日)。近いピークは高い方だけを残す
+	MinDistance int `json:"min_distance"`
+	// MinPeak は平滑化したピークの値の下限(感染者が少ない時期の揺らぎを除く)
+	MinPeak float64 `json:"min_peak"`
+}
+
+func DefaultWaveOptions() WaveOptions {
+	return WaveOptions{
+		SmoothingWindow: DefaultSmoothingWindow,
+		MinProminence:   DefaultMinProminence,
+		MinDistance:     DefaultMinDistance,
+		MinPeak:         DefaultMinPeak,
+	}
+}
+
+// Wave は流行の波。Start は前の谷、End は次の谷の前日(最後の波は系列の最後の日)
+type Wave struct {
+	Number int       `json:"number"`
+	Start  date.Date `json:"start"`
+	Peak   date.Date `json:"peak"`
+	// PeakValue は平滑化したピークの値
+	PeakValue  float64   `json:"peak_value"`
+	End        date.Date `json:"end"`
+	TotalCases float64   `json:"total_cases"`
+}
+
+// DetectWaves は平滑化した系列のピークと谷から流行の波を検出する
+// 系列の最後の日が増加中ならピークとして扱う(流行中の波も検出する)
+func DetectWaves(series Series, opts WaveOptions) ([]Wave, error) {
+	if opts.SmoothingWindow < 1 {
+		return nil, fmt.Errorf("invalid smoothing window: %v", opts.SmoothingWindow)
+	}
+	if opts.MinProminence < 0 || opts.MinProminence > 1 {
+		return nil, fmt.Errorf("invalid min prominence: %v", opts.MinProminence)
+	}
+	if opts.MinDistance < 0 {
+		return nil, fmt.Errorf("invalid min distance: %v", opts.MinDistance)
+	}
+
+	smoothed := centeredMovingAverage(series.Values, opts.SmoothingWindow)
+	peaks := findPeaks(smoothed, opts)
+	if len(peaks) == 0 {
+		return []Wave{}, nil
+	}
+
+	// 波の境界(谷)はピークの間の最小値。最初の波は最初のピークより前の最小値から始める
+	starts := []int{argMin(smoothed, 0, peaks[0])}
+	for i := 1; i < len(peaks); i++ {
+		starts = append(starts, argMin(smoothed, peaks[i-1], peaks[i]))
+	}
+
+	waves := make([]Wave, 0, len(peaks))
+	for i, peak := range peaks {
+		end := series.Len() - 1
+		if i+1 < len(starts) {
+			end = starts[i+1] - 1
+		}
+		var total float64
+		for k := starts[i]; k <= end; k++ {
+			total += series.Values[k]
+		}
+		waves = append(waves, Wave{
+			Number:     i + 1,
+			Start:      series.Date(starts[i]),
+			Peak:       series.Date(peak),
+			PeakValue:  smoothed[peak],
+			End:        series.Date(end),
+			TotalCases: total,
+		})
+	}
+	return waves, nil
+}
+
+// findPeaks はプロミネンスと間隔の条件を満たすピークの位置を古い順に返す
+func findPeaks(values []float64, opts WaveOptions) []int {
+	n := len(values)
+	var candidates []int
+	for i := 1; i < n; i++ {
+		if values[i] <= values[i-1] {
+			continue
+		}
+		// 同じ値が続く場合は中央をピークにする
+		j := i
+		for j+1 < n && values[j+1] == values[i] {
+			j++
+		}
+		if j+1 == n || values[j+1] < values[i] {
+			candidates = append(candidates, (i+j)/2)
+		}
+		i = j
+	}
+
+	var peaks []int
+	for _, p := range candidates {
+		if values[p] < opts.MinPeak || values[p] <= 0 {
+			continue
+		}
+		if prominence(values, p)/values[p] >= opts.MinProminence {
+			peaks = append(peaks, p)
+		}
+	}
+
+	// 高いピークから順に、近くにあるより低いピークを除く
+	sort.Slice(peaks, func(i, j int) bool { return values[peaks[i]] > values[peaks[j]] })
+	var kept []int
+	for _, p := range peaks {
+		near := false
+		for _, k := range kept {
+			if abs(p-k) < opts.MinDistance {
+				near = true
+				break
+			}
+		}
+		if !near {
+			kept = append(kept, p)
+		}
+	}
+	sort.Ints(kept)
+	return kept
+}
+
+// prominence はピークの両側で、より高い点(無ければ系列の端)までの最小値のうち高い方からの高さ
+// 系列の最後の日のピークは左側だけで求める
+func prominence(values []float64, p int) float64 {
+	leftMin := values[p]
+	for k := p - 1; k >= 0 && values[k] <= values[p]; k-- {
+		leftMin = math.Min(leftMin, values[k])
+	}
+	if p == len(values)-1 {
+		return values[p] - leftMin
+	}
+	rightMin := values[p]
+	for k := p + 1; k < len(values) && values[k] <= values[p]; k++ {
+		rightMin = math.Min(rightMin, values[k])
+	}
+	return values[p] - math.Max(leftMin, rightMin)
+}
+
+// argMin は values[from:to+1] の最小値の位置(同じ値は早い方)
+func argMin(values []float64, from int, to int) int {
+	min := from
+	for k := from + 1; k <= to; k++ {
+		if values[k] < values[min] {
+			min = k
+		}
+	}
+	return min
+}
+
+// centeredMovingAverage は前後 window/2 日の移動平均。系列の端はある日だけで平均する
+func centeredMovingAverage(values []float64, window int) []float64 {
+	smoothed := make([]float64, len(values))
+	before := (window - 1) / 2
+	after := window - 1 - before
+	for i := range values {
+		from, to := i-before, i+after
+		if from < 0 {
+			from = 0
+		}
+		if to > len(values)-1 {
+			to = len(values) - 1
+		}
+		var sum float64
+		for k := from; k <= to; k++ {
+			sum += values[k]
+		}
+		smoothed[i] = sum / float64(to-from+1)
+	}
+	return smoothed
+}
+
+func abs(x int) int {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
@@ -0,0 +1,92 @@
+package analytics
+
+import (
+	"corona-api/src/modules/date"
+	"github.com/stretchr/testify/assert"
+	"math"
+	"testing"
+)
+
+// bumps は中心 center、高さ height、幅 width のガウス関数を重ねた系列
+func bumps(days int, centers []int, heights []float64, width float64) []float64 {
+	values := make([]float64, days)
+	for i := range values {
+		for k, center := range centers {
+			values[i] += heights[k] * math.Exp(-math.Pow(float64(i-center)/width, 2)/2)
+		}
+		values[i] = math.Round(values[i])
+	}
+	return values
+}
+
+func TestDetectWaves(t *testing.T) {
+	// 規模の違う3つの波
+	start := date.MustParse("2022-01-01")
+	values := bumps(300, []int{50, 150, 250}, []float64{100, 1000, 10000}, 12)
+	waves, err := DetectWaves(Series{Start: start, Values: values}, DefaultWaveOptions())
+	assert.NoError(t, err)
+	assert.Len(t, waves, 3)
+
+	var total float64
+	for i, w := range waves {
+		assert.Equal(t, i+1, w.Number)
+		total += w.TotalCases
+	}
+	assert.Equal(t, start.AddDays(50), waves[0].Peak)
+	assert.Equal(t, start.AddDays(150), waves[1].Peak)
+	assert.Equal(t, start.AddDays(250), waves[2].Peak)
+	assert.InDelta(t, 10000, waves[2].PeakValue, 200)
+
+	// 波は重ならずに続き、最後の波は系列の最後の日まで
+	assert.Equal(t, waves[1].Start, waves[0].End.AddDays(1))
+	assert.Equal(t, waves[2].Start, waves[1].End.AddDays(1))
+	assert.Equal(t, start.AddDays(299), waves[2].End)
+	var want float64
+	for _, v := range values[waves[0].Start.Sub(start):] {
+		want += v
+	}
+	assert.Equal(t, want, total)
+}
+
+func TestDetectWaves_Options(t *testing.T) {
+	start := date.MustParse("2022-01-01")
+
+	// 20日しか離れていないピークは高い方だけ
+	values := bumps(200, []int{80, 100}, []float64{1000, 800}, 4)
+	waves, err := DetectWaves(Series{Start: start, Values: values}, DefaultWaveOptions())
+	assert.NoError(t, err)
+	assert.Len(t, waves, 1)
+	assert.Equal(t, start.AddDays(80), waves[0].Peak)
+
+	opts := DefaultWaveOptions()
+	opts.MinDistance = 10
+	waves, err = DetectWaves(Series{Start: start, Values: values}, opts)
+	assert.NoError(t, err)
+	assert.Len(t, waves, 2)
+
+	// 減少中の小さな肩はプロミネンスが小さいので波にしない
+	values = bumps(200, []int{60, 110}, []float64{1000, 150}, 15)
+	waves, err = DetectWaves(Series{Start: start, Values: values}, DefaultWaveOptions())
+	assert.NoError(t, err)
+	assert.Len(t, waves, 1)
+	opts = DefaultWaveOptions()
+	opts.MinProminence = 0
+	waves, err = DetectWaves(Series{Start: start, Values: values}, opts)
+	assert.NoError(t, err)
+	assert.Len(t, waves, 2)
+
+	// 系列の最後まで増加している波も検出する
+	values = bumps(100, []int{30, 120}, []float64{500, 800}, 10)
+	waves, err = DetectWaves(Series{Start: start, Values: values}, DefaultWaveOptions())
+	assert.NoError(t, err)
+	assert.Len(t, waves, 2)
+	assert.Equal(t, start.AddDays(99), waves[1].Peak)
+
+	_, err = DetectWaves(Series{Start: start, Values: values}, WaveOptions{SmoothingWindow: 0})
+	assert.Error(t, err)
+}
+
+func TestCenteredMovingAverage(t *testing.T) {
+	assert.Equal(t, []float64{1.5, 2, 3, 3.5}, centeredMovingAverage([]float64{1, 2, 3, 4}, 3))
+	assert.Equal(t, []float64{1, 2, 3}, centeredMovingAverage([]float64{1, 2, 3}, 1))
+}
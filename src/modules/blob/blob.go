@@ -0,0 +1,144 @@
+package blob
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3iface"
+	"github.com/aws/aws-sdk-go/service/s3/s3manager"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+const (
+	StoreS3   = "s3"
+	StoreFile = "file"
+)
+
+var ErrNotFound = errors.New("blob not found")
+
+// Store は取得したファイルの保存先
+type Store interface {
+	Put(ctx context.Context, key string, body []byte) error
+	Get(ctx context.Context, key string) ([]byte, error)
+}
+
+// NewStore は環境変数 BLOB_STORE の保存先を返す
+// file の場合は BLOB_DIR のディレクトリ、それ以外は patient-details-file-{ENV} のS3バケットに保存する
+func NewStore() (Store, error) {
+	switch os.Getenv("BLOB_STORE") {
+	case "", StoreS3:
+		return NewS3Store(fmt.Sprintf("patient-details-file-%s", os.Getenv("ENV")))
+	case StoreFile:
+		return NewFileStore(os.Getenv("BLOB_DIR"))
+	}
+	return nil, fmt.Errorf("unknown blob store: %v", os.Getenv("BLOB_STORE"))
+}
+
+// S3Store はS3のバケットに保存する
+type S3Store struct {
+	Bucket string
+	Client s3iface.S3API
+}
+
+func NewS3Store(bucket string) (*S3Store, error) {
+	// セッション
+	sess, err := session.NewSessionWithOptions(session.Options{
+		Config: aws.Config{Region: aws.String(os.Getenv("REGION"))},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("session.NewSessionWithOptions() error: %w", err)
+	}
+	return &S3Store{Bucket: bucket, Client: s3.New(sess)}, nil
+}
+
+func (s *S3Store) Put(ctx context.Context, key string, body []byte) error {
+	upload := s3manager.NewUploaderWithClient(s.Client)
+	_, err := upload.UploadWithContext(ctx, &s3manager.UploadInput{
+		Bucket: aws.String(s.Bucket),
+		Key:    aws.String(key),
+		Body:   bytes.NewReader(body),
+	})
+	if err != nil {
+		return fmt.Errorf("upload.Upload() error: %w", err)
+	}
+	return nil
+}
+
+func (s *S3Store) Get(ctx context.Context, key string) ([]byte, error) {
+	obj, err := s.Client.GetObjectWithContext(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(s.Bucket),
+		Key:    aws.String(key),
+	})
+	var awsErr awserr.Error
+	if errors.As(err, &awsErr) && awsErr.Code() == s3.ErrCodeNoSuchKey {
+		return nil, fmt.Errorf("%w: %v", ErrNotFound, key)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("svc.GetObject() error: %w", err)
+	}
+	defer obj.Body.Close()
+	return io.ReadAll(obj.Body)
+}
+
+// FileStore はローカルのディレクトリに保存する(AWSを使わないオフライン用)
+type FileStore struct {
+	Dir string
+}
+
+func NewFileStore(dir string) (*FileStore, error) {
+	if dir == "" {
+		return nil, fmt.Errorf("blob directory is empty")
+	}
+	return &FileStore{Dir: dir}, nil
+}
+
+func (s *FileStore) Put(ctx context.Context, key string, body []byte) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("os.MkdirAll() error: %w", err)
+	}
+	// 書き込み途中のファイルを読まないよう、一時ファイルに書いてから置き換える
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, body, 0o644); err != nil {
+		return fmt.Errorf("os.WriteFile() error: %w", err)
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		return fmt.Errorf("os.Rename() error: %w", err)
+	}
+	return nil
+}
+
+func (s *FileStore) Get(ctx context.Context, key string) ([]byte, error) {
+	path, err := s.path(key)
+	if err != nil {
+		return nil, err
+	}
+	body, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf("%w: %v", ErrNotFound, key)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("os.ReadFile() error: %w", err)
+	}
+	return body, nil
+}
+
+// path はキーのファイルのパス。ディレクトリの外を指すキーはエラーにする
+func (s *FileStore) path(key string) (string, error) {
+	cleaned := filepath.Clean(filepath.FromSlash(key))
+	if key == "" || filepath.IsAbs(cleaned) || cleaned == ".." || strings.HasPrefix(cleaned, ".."+string(filepath.Separator)) {
+		return "", fmt.Errorf("invalid blob key: %v", key)
+	}
+	return filepath.Join(s.Dir, cleaned), nil
+}
@@ -0,0 +1,31 @@
+package blob
+
+import (
+	"context"
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestFileStore(t *testing.T) {
+	store, err := NewFileStore(t.TempDir())
+	assert.NoError(t, err)
+	ctx := context.Background()
+
+	assert.NoError(t, store.Put(ctx, "20230101090000", []byte(`{"itemList":[]}`)))
+	body, err := store.Get(ctx, "20230101090000")
+	assert.NoError(t, err)
+	assert.Equal(t, `{"itemList":[]}`, string(body))
+
+	_, err = store.Get(ctx, "20230102090000")
+	assert.True(t, errors.Is(err, ErrNotFound))
+}
+
+func TestFileStore_InvalidKey(t *testing.T) {
+	store, err := NewFileStore(t.TempDir())
+	assert.NoError(t, err)
+
+	for _, key := range []string{"", "../outside", "/etc/passwd", "a/../../outside"} {
+		assert.Error(t, store.Put(context.Background(), key, []byte("x")), key)
+	}
+}
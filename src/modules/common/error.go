@@ -2,37 +2,195 @@ package common
 
 import (
 	"encoding/json"
+	"errors"
+	"fmt"
 	"github.com/aws/aws-lambda-go/events"
 	"log"
 	"net/http"
+	"strings"
 )
 
 const (
 	BadRequestMessage          = "リクエストパラメータが不正です"
+	UnauthorizedMessage        = "APIキーが無いか正しくありません"
+	NotFoundMessage            = "指定された条件のデータが見つかりません"
+	ServiceUnavailableMessage  = "現在サービスを利用できません。時間をおいて再度お試しください"
 	InternalServerErrorMessage = "サーバーエラーが発生しました。運営にお問合せください"
 )
 
-type CustomLambdaErrorResponse struct {
-	ErrorMessage string `json:"errorMessage"`
-	HttpStatus   int    `json:"httpStatus"`
+type ErrorCode string
+
+const (
+	ErrorCodeValidation          ErrorCode = "VALIDATION_ERROR"
+	ErrorCodeUnauthorized        ErrorCode = "UNAUTHORIZED"
+	ErrorCodeNotFound            ErrorCode = "NOT_FOUND"
+	ErrorCodeUpstreamUnavailable ErrorCode = "UPSTREAM_UNAVAILABLE"
+	ErrorCodeInternal            ErrorCode = "INTERNAL_ERROR"
+)
+
+// 項目単位のエラー理由
+const (
+	FieldReasonRequired      = "required"
+	FieldReasonInvalidFormat = "invalid_format"
+	FieldReasonOutOfRange    = "out_of_range"
+	FieldReasonInvalidOrder  = "invalid_order"
+	FieldReasonConflict      = "conflict"
+	FieldReasonNotAllowed    = "not_allowed"
+)
+
+type FieldError struct {
+	Field   string `json:"field"`
+	Reason  string `json:"reason"`
+	Message string `json:"message,omitempty"`
 }
 
-func APIGatewayProxyErrorResponse(err error, errorMessage string, httpStatus int) (events.APIGatewayProxyResponse, error) {
-	// ロギング
-	log.Println(err)
+type ValidationError struct {
+	Fields []FieldError
+	Err    error
+}
 
-	// レスポンス生成
+func NewValidationError(err error, fields ...FieldError) *ValidationError {
+	return &ValidationError{Fields: fields, Err: err}
+}
+
+func (e *ValidationError) Error() string {
+	reasons := make([]string, 0, len(e.Fields))
+	for _, f := range e.Fields {
+		reasons = append(reasons, fmt.Sprintf("%s: %s", f.Field, f.Reason))
+	}
+	if e.Err == nil {
+		return fmt.Sprintf("validation error: [%s]", strings.Join(reasons, ", "))
+	}
+	return fmt.Sprintf("validation error: [%s]: %v", strings.Join(reasons, ", "), e.Err)
+}
+
+func (e *ValidationError) Unwrap() error {
+	return e.Err
+}
+
+type UnauthorizedError struct {
+	Err error
+}
+
+func NewUnauthorizedError(err error) *UnauthorizedError {
+	return &UnauthorizedError{Err: err}
+}
+
+func (e *UnauthorizedError) Error() string {
+	return fmt.Sprintf("unauthorized: %v", e.Err)
+}
+
+func (e *UnauthorizedError) Unwrap() error {
+	return e.Err
+}
+
+type NotFoundError struct {
+	Err error
+}
+
+func NewNotFoundError(err error) *NotFoundError {
+	return &NotFoundError{Err: err}
+}
+
+func (e *NotFoundError) Error() string {
+	return fmt.Sprintf("not found: %v", e.Err)
+}
+
+func (e *NotFoundError) Unwrap() error {
+	return e.Err
+}
+
+type UpstreamUnavailableError struct {
+	Err error
+}
+
+func NewUpstreamUnavailableError(err error) *UpstreamUnavailableError {
+	return &UpstreamUnavailableError{Err: err}
+}
+
+func (e *UpstreamUnavailableError) Error() string {
+	return fmt.Sprintf("upstream unavailable: %v", e.Err)
+}
+
+func (e *UpstreamUnavailableError) Unwrap() error {
+	return e.Err
+}
+
+type InternalError struct {
+	Err error
+}
+
+func NewInternalError(err error) *InternalError {
+	return &InternalError{Err: err}
+}
+
+func (e *InternalError) Error() string {
+	return fmt.Sprintf("internal error: %v", e.Err)
+}
+
+func (e *InternalError) Unwrap() error {
+	return e.Err
+}
+
+// ErrorStatus はエラーの種類からHTTPステータスとエラーコードを決定する
+// 種類が判別できないエラーは内部エラーとして扱う
+func ErrorStatus(err error) (int, ErrorCode) {
+	var validationErr *ValidationError
+	var unauthorizedErr *UnauthorizedError
+	var notFoundErr *NotFoundError
+	var upstreamErr *UpstreamUnavailableError
+	switch {
+	case errors.As(err, &validationErr):
+		return http.StatusBadRequest, ErrorCodeValidation
+	case errors.As(err, &unauthorizedErr):
+		return http.StatusUnauthorized, ErrorCodeUnauthorized
+	case errors.As(err, &notFoundErr):
+		return http.StatusNotFound, ErrorCodeNotFound
+	case errors.As(err, &upstreamErr):
+		return http.StatusServiceUnavailable, ErrorCodeUpstreamUnavailable
+	default:
+		return http.StatusInternalServerError, ErrorCodeInternal
+	}
+}
+
+type CustomLambdaErrorResponse struct {
+	ErrorCode    ErrorCode    `json:"errorCode"`
+	ErrorMessage string       `json:"errorMessage"`
+	HttpStatus   int          `json:"httpStatus"`
+	Details      []FieldError `json:"details,omitempty"`
+}
+
+// ErrorResponseBody はエラーからHTTPステータスとレスポンスボディを生成する
+func ErrorResponseBody(err error, lang Language) (int, []byte) {
+	httpStatus, code := ErrorStatus(err)
 	res := &CustomLambdaErrorResponse{
-		ErrorMessage: errorMessage,
+		ErrorCode:    code,
+		ErrorMessage: ErrorMessage(code, lang),
 		HttpStatus:   httpStatus,
 	}
-	body, err := json.Marshal(res)
-	if err != nil {
-		log.Printf("{\"level\":\"warn\",\"error_message\":\"%s\"}", err.Error())
+	var validationErr *ValidationError
+	if errors.As(err, &validationErr) {
+		for _, f := range validationErr.Fields {
+			f.Message = FieldReasonMessage(f.Reason, lang)
+			res.Details = append(res.Details, f)
+		}
 	}
+	body, marshalErr := json.Marshal(res)
+	if marshalErr != nil {
+		log.Printf("{\"level\":\"warn\",\"error_message\":\"%s\"}", marshalErr.Error())
+	}
+	return httpStatus, body
+}
 
+func APIGatewayProxyErrorResponse(err error, lang Language) (events.APIGatewayProxyResponse, error) {
+	// ロギング
+	log.Println(err)
+
+	// レスポンス生成
+	httpStatus, body := ErrorResponseBody(err, lang)
 	return events.APIGatewayProxyResponse{
+		Headers:    map[string]string{"Content-Language": string(lang)},
 		Body:       string(body),
-		StatusCode: http.StatusBadRequest,
-	}, err
+		StatusCode: httpStatus,
+	}, nil
 }
@@ -0,0 +1,60 @@
+package common
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"testing"
+)
+
+func TestErrorStatus(t *testing.T) {
+	tests := []struct {
+		name       string
+		err        error
+		wantStatus int
+		wantCode   ErrorCode
+	}{
+		{"validation", NewValidationError(errors.New("bad")), http.StatusBadRequest, ErrorCodeValidation},
+		{"unauthorized", NewUnauthorizedError(errors.New("no key")), http.StatusUnauthorized, ErrorCodeUnauthorized},
+		{"not found", NewNotFoundError(errors.New("none")), http.StatusNotFound, ErrorCodeNotFound},
+		{"upstream unavailable", NewUpstreamUnavailableError(errors.New("down")), http.StatusServiceUnavailable, ErrorCodeUpstreamUnavailable},
+		{"internal", NewInternalError(errors.New("bug")), http.StatusInternalServerError, ErrorCodeInternal},
+		{"wrapped", fmt.Errorf("handler: %w", NewNotFoundError(errors.New("none"))), http.StatusNotFound, ErrorCodeNotFound},
+		{"untyped", errors.New("unknown"), http.StatusInternalServerError, ErrorCodeInternal},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			status, code := ErrorStatus(tt.err)
+			assert.Equal(t, tt.wantStatus, status)
+			assert.Equal(t, tt.wantCode, code)
+		})
+	}
+}
+
+func TestAPIGatewayProxyErrorResponse(t *testing.T) {
+	err := NewValidationError(errors.New("bad"), FieldError{Field: "area", Reason: FieldReasonRequired})
+	res, lambdaErr := APIGatewayProxyErrorResponse(err, LanguageJa)
+	assert.NoError(t, lambdaErr)
+	assert.Equal(t, http.StatusBadRequest, res.StatusCode)
+	assert.Equal(t, "ja", res.Headers["Content-Language"])
+
+	var body CustomLambdaErrorResponse
+	assert.NoError(t, json.Unmarshal([]byte(res.Body), &body))
+	assert.Equal(t, ErrorCodeValidation, body.ErrorCode)
+	assert.Equal(t, BadRequestMessage, body.ErrorMessage)
+	assert.Equal(t, http.StatusBadRequest, body.HttpStatus)
+	assert.Equal(t, []FieldError{{Field: "area", Reason: FieldReasonRequired, Message: "必須項目です"}}, body.Details)
+}
+
+func TestAPIGatewayProxyErrorResponse_English(t *testing.T) {
+	res, _ := APIGatewayProxyErrorResponse(NewNotFoundError(errors.New("none")), LanguageEn)
+	assert.Equal(t, http.StatusNotFound, res.StatusCode)
+
+	var body CustomLambdaErrorResponse
+	assert.NoError(t, json.Unmarshal([]byte(res.Body), &body))
+	assert.Equal(t, NotFoundMessageEn, body.ErrorMessage)
+}
@@ -0,0 +1,167 @@
+package common
+
+import (
+	"github.com/aws/aws-lambda-go/events"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+type Language string
+
+const (
+	LanguageJa      Language = "ja"
+	LanguageEn      Language = "en"
+	DefaultLanguage          = LanguageJa
+)
+
+const (
+	BadRequestMessageEn          = "The request parameters are invalid"
+	UnauthorizedMessageEn        = "The API key is missing or invalid"
+	NotFoundMessageEn            = "No data matched the specified conditions"
+	ServiceUnavailableMessageEn  = "The service is temporarily unavailable. Please try again later"
+	InternalServerErrorMessageEn = "An internal server error occurred. Please contact the administrator"
+)
+
+// エラーコードごとのメッセージカタログ
+var errorMessages = map[ErrorCode]map[Language]string{
+	ErrorCodeValidation: {
+		LanguageJa: BadRequestMessage,
+		LanguageEn: BadRequestMessageEn,
+	},
+	ErrorCodeUnauthorized: {
+		LanguageJa: UnauthorizedMessage,
+		LanguageEn: UnauthorizedMessageEn,
+	},
+	ErrorCodeNotFound: {
+		LanguageJa: NotFoundMessage,
+		LanguageEn: NotFoundMessageEn,
+	},
+	ErrorCodeUpstreamUnavailable: {
+		LanguageJa: ServiceUnavailableMessage,
+		LanguageEn: ServiceUnavailableMessageEn,
+	},
+	ErrorCodeInternal: {
+		LanguageJa: InternalServerErrorMessage,
+		LanguageEn: InternalServerErrorMessageEn,
+	},
+}
+
+// 項目単位のエラー理由ごとのメッセージカタログ
+var fieldReasonMessages = map[string]map[Language]string{
+	FieldReasonRequired: {
+		LanguageJa: "必須項目です",
+		LanguageEn: "This parameter is required",
+	},
+	FieldReasonInvalidFormat: {
+		LanguageJa: "形式が不正です",
+		LanguageEn: "The format is invalid",
+	},
+	FieldReasonOutOfRange: {
+		LanguageJa: "指定可能な範囲外です",
+		LanguageEn: "The value is out of range",
+	},
+	FieldReasonInvalidOrder: {
+		LanguageJa: "開始日は終了日以前を指定してください",
+		LanguageEn: "The start date must not be after the end date",
+	},
+	FieldReasonConflict: {
+		LanguageJa: "他の項目と同時に指定できません",
+		LanguageEn: "This parameter cannot be combined with other parameters",
+	},
+	FieldReasonNotAllowed: {
+		LanguageJa: "送信できない宛先です",
+		LanguageEn: "The destination is not allowed",
+	},
+}
+
+func ErrorMessage(code ErrorCode, lang Language) string {
+	messages, ok := errorMessages[code]
+	if !ok {
+		messages = errorMessages[ErrorCodeInternal]
+	}
+	if message, ok := messages[lang]; ok {
+		return message
+	}
+	return messages[DefaultLanguage]
+}
+
+func FieldReasonMessage(reason string, lang Language) string {
+	messages, ok := fieldReasonMessages[reason]
+	if !ok {
+		return reason
+	}
+	if message, ok := messages[lang]; ok {
+		return message
+	}
+	return messages[DefaultLanguage]
+}
+
+// DetectLanguage は lang パラメータ、Accept-Language ヘッダの順に言語を決定する
+func DetectLanguage(langParam string, acceptLanguage string) Language {
+	if lang, ok := parseLanguage(langParam); ok {
+		return lang
+	}
+	return ParseAcceptLanguage(acceptLanguage)
+}
+
+// RequestLanguage はAPI Gatewayのリクエストから言語を決定する
+func RequestLanguage(request events.APIGatewayProxyRequest) Language {
+	var acceptLanguage string
+	for key, value := range request.Headers {
+		if strings.EqualFold(key, "Accept-Language") {
+			acceptLanguage = value
+		}
+	}
+	return DetectLanguage(request.QueryStringParameters["lang"], acceptLanguage)
+}
+
+// ParseAcceptLanguage は q 値の高い順に対応している言語を探す
+func ParseAcceptLanguage(header string) Language {
+	type candidate struct {
+		tag     string
+		quality float64
+	}
+	var candidates []candidate
+	for _, part := range strings.Split(header, ",") {
+		fields := strings.Split(strings.TrimSpace(part), ";")
+		tag := strings.TrimSpace(fields[0])
+		if tag == "" {
+			continue
+		}
+		quality := 1.0
+		for _, param := range fields[1:] {
+			param = strings.TrimSpace(param)
+			if strings.HasPrefix(param, "q=") {
+				q, err := strconv.ParseFloat(strings.TrimPrefix(param, "q="), 64)
+				if err == nil {
+					quality = q
+				}
+			}
+		}
+		candidates = append(candidates, candidate{tag, quality})
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].quality > candidates[j].quality
+	})
+	for _, c := range candidates {
+		if c.quality <= 0 {
+			continue
+		}
+		if lang, ok := parseLanguage(c.tag); ok {
+			return lang
+		}
+	}
+	return DefaultLanguage
+}
+
+func parseLanguage(tag string) (Language, bool) {
+	primary := strings.ToLower(strings.SplitN(strings.TrimSpace(tag), "-", 2)[0])
+	switch Language(primary) {
+	case LanguageJa:
+		return LanguageJa, true
+	case LanguageEn:
+		return LanguageEn, true
+	}
+	return "", false
+}
@@ -0,0 +1,40 @@
+package common
+
+import (
+	"github.com/aws/aws-lambda-go/events"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestParseAcceptLanguage(t *testing.T) {
+	tests := []struct {
+		header string
+		want   Language
+	}{
+		{"", LanguageJa},
+		{"en", LanguageEn},
+		{"en-US,en;q=0.9", LanguageEn},
+		{"fr-FR, en;q=0.5, ja;q=0.8", LanguageJa},
+		{"fr-FR, en;q=0.5", LanguageEn},
+		{"ja;q=0, en;q=0.1", LanguageEn},
+		{"de", LanguageJa},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.header, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.want, ParseAcceptLanguage(tt.header))
+		})
+	}
+}
+
+func TestRequestLanguage(t *testing.T) {
+	request := events.APIGatewayProxyRequest{
+		Headers:               map[string]string{"accept-language": "ja"},
+		QueryStringParameters: map[string]string{"lang": "en"},
+	}
+	assert.Equal(t, LanguageEn, RequestLanguage(request))
+
+	request.QueryStringParameters = map[string]string{}
+	assert.Equal(t, LanguageJa, RequestLanguage(request))
+}
@@ -0,0 +1,75 @@
+package date
+
+import (
+	"log"
+	"os"
+	"time"
+)
+
+// DefaultTimeZone は環境変数 TZ が未設定のときのタイムゾーン
+const DefaultTimeZone = "Asia/Tokyo"
+
+// jst は tzdata が無い環境で Asia/Tokyo の代わりに使う(日本は夏時間が無いので固定の時差でよい)
+var jst = time.FixedZone("JST", 9*60*60)
+
+// Clock は現在時刻の取得元。テストでは FixedClock に差し替えて日付を固定する
+type Clock interface {
+	Now() time.Time
+}
+
+// Location は環境変数 TZ のタイムゾーン。未設定または読み込めない場合は Asia/Tokyo
+func Location() *time.Location {
+	name := os.Getenv("TZ")
+	if name == "" {
+		name = DefaultTimeZone
+	}
+	loc, err := time.LoadLocation(name)
+	if err == nil {
+		return loc
+	}
+	if name != DefaultTimeZone {
+		log.Printf("time.LoadLocation() error: %v, use %v", err, DefaultTimeZone)
+	}
+	loc, err = time.LoadLocation(DefaultTimeZone)
+	if err != nil {
+		return jst
+	}
+	return loc
+}
+
+// SystemClock はOSの時計。Location が nil なら Location() のタイムゾーンにする
+type SystemClock struct {
+	Location *time.Location
+}
+
+func (c SystemClock) Now() time.Time {
+	loc := c.Location
+	if loc == nil {
+		loc = Location()
+	}
+	return time.Now().In(loc)
+}
+
+// FixedClock は常に同じ時刻を返す
+type FixedClock struct {
+	Time time.Time
+}
+
+func (c FixedClock) Now() time.Time {
+	return c.Time
+}
+
+// OffsetClock は Clock の時刻を Offset だけずらす
+type OffsetClock struct {
+	Clock  Clock
+	Offset time.Duration
+}
+
+func (c OffsetClock) Now() time.Time {
+	return c.Clock.Now().Add(c.Offset)
+}
+
+// Today は clock のタイムゾーンでの今日の日付
+func Today(clock Clock) Date {
+	return Of(clock.Now())
+}
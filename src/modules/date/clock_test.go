@@ -0,0 +1,50 @@
+package date
+
+import (
+	"github.com/stretchr/testify/assert"
+	"os"
+	"testing"
+	"time"
+)
+
+func TestLocation(t *testing.T) {
+	tz, ok := os.LookupEnv("TZ")
+	defer func() {
+		if ok {
+			os.Setenv("TZ", tz)
+		} else {
+			os.Unsetenv("TZ")
+		}
+	}()
+
+	os.Unsetenv("TZ")
+	assert.Equal(t, 9*60*60, offset(Location()))
+
+	os.Setenv("TZ", "UTC")
+	assert.Equal(t, 0, offset(Location()))
+
+	// 読み込めないタイムゾーンは Asia/Tokyo にする
+	os.Setenv("TZ", "Invalid/Zone")
+	assert.Equal(t, 9*60*60, offset(Location()))
+}
+
+func offset(loc *time.Location) int {
+	_, offset := time.Date(2023, 1, 1, 0, 0, 0, 0, loc).Zone()
+	return offset
+}
+
+func TestClock(t *testing.T) {
+	jst := time.FixedZone("JST", 9*60*60)
+	fixed := FixedClock{Time: time.Date(2023, 1, 1, 23, 30, 0, 0, jst)}
+	assert.Equal(t, MustParse("2023-01-01"), Today(fixed))
+
+	// 1時間後は翌日
+	assert.Equal(t, MustParse("2023-01-02"), Today(OffsetClock{Clock: fixed, Offset: time.Hour}))
+
+	// UTCではまだ前日の14:30
+	assert.Equal(t, MustParse("2023-01-01"), Today(FixedClock{Time: fixed.Time.UTC()}))
+
+	system := SystemClock{Location: time.UTC}
+	assert.Equal(t, time.UTC, system.Now().Location())
+	assert.WithinDuration(t, time.Now(), system.Now(), time.Second)
+}
@@ -1,21 +1,229 @@
 package date
 
 import (
-	"os"
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
 	"strconv"
+	"strings"
 	"time"
 )
 
-func GetToday() (int, error) {
-	jst, err := time.LoadLocation(os.Getenv("TZ"))
+const (
+	// Layout はDBとAPIのパラメーターで使う yyyymmdd 形式
+	Layout = "20060102"
+	// ISOLayout は yyyy-mm-dd 形式
+	ISOLayout = "2006-01-02"
+)
+
+// Date は時刻とタイムゾーンを持たない日付
+// 比較できるので map のキーにも使える。ゼロ値は日付が未設定であることを表す
+type Date struct {
+	Year  int
+	Month time.Month
+	Day   int
+}
+
+// New は日付を作る。範囲外の値は time.Date と同じく正規化する(1月32日は2月1日)
+func New(year int, month time.Month, day int) Date {
+	return Of(time.Date(year, month, day, 0, 0, 0, 0, time.UTC))
+}
+
+// Of は t のタイムゾーンでの日付
+func Of(t time.Time) Date {
+	year, month, day := t.Date()
+	return Date{Year: year, Month: month, Day: day}
+}
+
+// Parse は yyyymmdd, yyyy-mm-dd, ISO 8601 の日時(2023-01-01T09:00:00+09:00)を日付にする
+// 日時の場合はその時差での日付にする
+func Parse(s string) (Date, error) {
+	for _, layout := range []string{Layout, ISOLayout, time.RFC3339, "2006-01-02T15:04:05", "20060102T150405Z0700"} {
+		t, err := time.Parse(layout, s)
+		if err == nil {
+			return Of(t), nil
+		}
+	}
+	return Date{}, fmt.Errorf("invalid date: %q", s)
+}
+
+// MustParse は Parse に失敗すると panic する。定数やテストで使う
+func MustParse(s string) Date {
+	d, err := Parse(s)
 	if err != nil {
-		return -1, err
+		panic(err)
+	}
+	return d
+}
+
+// FromYYYYMMDD は 20230101 のような数値を日付にする
+func FromYYYYMMDD(yyyymmdd uint32) (Date, error) {
+	d, err := Parse(strconv.FormatUint(uint64(yyyymmdd), 10))
+	if err != nil || d.YYYYMMDD() != yyyymmdd {
+		return Date{}, fmt.Errorf("invalid date: %v", yyyymmdd)
 	}
-	now := time.Now().In(jst)
-	today := now.Format("20060102")
-	todayInt, err := strconv.Atoi(today)
+	return d, nil
+}
+
+// YYYYMMDD は 20230101 のような数値にする
+func (d Date) YYYYMMDD() uint32 {
+	return uint32(d.Year*10000 + int(d.Month)*100 + d.Day)
+}
+
+func (d Date) IsZero() bool {
+	return d == Date{}
+}
+
+// Time は loc でのその日の0時
+func (d Date) Time(loc *time.Location) time.Time {
+	return time.Date(d.Year, d.Month, d.Day, 0, 0, 0, 0, loc)
+}
+
+func (d Date) String() string {
+	return d.Format(ISOLayout)
+}
+
+// Format は time.Time と同じレイアウトで文字列にする
+func (d Date) Format(layout string) string {
+	return d.Time(time.UTC).Format(layout)
+}
+
+func (d Date) Weekday() time.Weekday {
+	return d.Time(time.UTC).Weekday()
+}
+
+func (d Date) AddDays(days int) Date {
+	return New(d.Year, d.Month, d.Day+days)
+}
+
+// Sub は d - other の日数
+func (d Date) Sub(other Date) int {
+	return int(d.Time(time.UTC).Sub(other.Time(time.UTC)).Hours() / 24)
+}
+
+func (d Date) Before(other Date) bool {
+	return d.Compare(other) < 0
+}
+
+func (d Date) After(other Date) bool {
+	return d.Compare(other) > 0
+}
+
+// Compare は d が other より前なら -1、同じなら 0、後なら 1 を返す
+func (d Date) Compare(other Date) int {
+	a, b := d.YYYYMMDD(), other.YYYYMMDD()
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+// StartOfWeek はその週の月曜日(ISO 8601 の週)
+func (d Date) StartOfWeek() Date {
+	offset := (int(d.Weekday()) + 6) % 7
+	return d.AddDays(-offset)
+}
+
+// EndOfWeek はその週の日曜日
+func (d Date) EndOfWeek() Date {
+	return d.StartOfWeek().AddDays(6)
+}
+
+func (d Date) StartOfMonth() Date {
+	return New(d.Year, d.Month, 1)
+}
+
+func (d Date) EndOfMonth() Date {
+	return New(d.Year, d.Month+1, 0)
+}
+
+// Value はDBへ yyyymmdd の数値で保存する。ゼロ値は NULL にする
+func (d Date) Value() (driver.Value, error) {
+	if d.IsZero() {
+		return nil, nil
+	}
+	return int64(d.YYYYMMDD()), nil
+}
+
+// Scan はDBの yyyymmdd の数値(ドライバーによっては文字列)を読み込む。NULL はゼロ値にする
+func (d *Date) Scan(src interface{}) error {
+	switch v := src.(type) {
+	case nil:
+		*d = Date{}
+		return nil
+	case int64:
+		if v < 0 || v > int64(^uint32(0)) {
+			return fmt.Errorf("invalid date: %v", v)
+		}
+		parsed, err := FromYYYYMMDD(uint32(v))
+		if err != nil {
+			return err
+		}
+		*d = parsed
+		return nil
+	case []byte:
+		return d.Scan(string(v))
+	case string:
+		parsed, err := Parse(v)
+		if err != nil {
+			return err
+		}
+		*d = parsed
+		return nil
+	case time.Time:
+		*d = Of(v)
+		return nil
+	}
+	return fmt.Errorf("unsupported date type: %T", src)
+}
+
+func (d Date) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+func (d *Date) UnmarshalText(text []byte) error {
+	parsed, err := Parse(string(text))
 	if err != nil {
-		return -1, err
+		return err
+	}
+	*d = parsed
+	return nil
+}
+
+// MarshalJSON は "2023-01-01" にする。ゼロ値は null にする
+func (d Date) MarshalJSON() ([]byte, error) {
+	if d.IsZero() {
+		return []byte("null"), nil
+	}
+	return json.Marshal(d.String())
+}
+
+// UnmarshalJSON は文字列のほか 20230101 のような数値も受け付ける
+func (d *Date) UnmarshalJSON(data []byte) error {
+	data = bytes.TrimSpace(data)
+	if string(data) == "null" {
+		*d = Date{}
+		return nil
+	}
+	if !strings.HasPrefix(string(data), `"`) {
+		yyyymmdd, err := strconv.ParseUint(string(data), 10, 32)
+		if err != nil {
+			return fmt.Errorf("invalid date: %s", data)
+		}
+		parsed, err := FromYYYYMMDD(uint32(yyyymmdd))
+		if err != nil {
+			return err
+		}
+		*d = parsed
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
 	}
-	return todayInt, nil
+	return d.UnmarshalText([]byte(s))
 }
@@ -0,0 +1,112 @@
+package date
+
+import (
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  Date
+	}{
+		{name: "yyyymmdd", input: "20230101", want: New(2023, time.January, 1)},
+		{name: "yyyy-mm-dd", input: "2023-01-01", want: New(2023, time.January, 1)},
+		{name: "ISO 8601 の日時はその時差での日付", input: "2023-01-01T23:30:00+09:00", want: New(2023, time.January, 1)},
+		{name: "UTC", input: "2022-12-31T15:00:00Z", want: New(2022, time.December, 31)},
+		{name: "基本形式", input: "20230101T093000+0900", want: New(2023, time.January, 1)},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := Parse(tt.input)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestParse_Invalid(t *testing.T) {
+	for _, input := range []string{"", "2023-02-30", "20231301", "2023/01/01", "abc"} {
+		_, err := Parse(input)
+		assert.Error(t, err, input)
+	}
+}
+
+func TestFromYYYYMMDD(t *testing.T) {
+	d, err := FromYYYYMMDD(20240229)
+	assert.NoError(t, err)
+	assert.Equal(t, New(2024, time.February, 29), d)
+	assert.Equal(t, uint32(20240229), d.YYYYMMDD())
+
+	_, err = FromYYYYMMDD(20230229)
+	assert.Error(t, err)
+}
+
+func TestDate_Arithmetic(t *testing.T) {
+	d := MustParse("2023-03-01")
+
+	assert.Equal(t, MustParse("2023-02-28"), d.AddDays(-1))
+	assert.Equal(t, MustParse("2023-03-08"), d.AddDays(7))
+	assert.Equal(t, 29, MustParse("2024-03-01").Sub(MustParse("2024-02-01")))
+	assert.True(t, d.After(d.AddDays(-1)))
+	assert.True(t, d.Before(d.AddDays(1)))
+	assert.Equal(t, 0, d.Compare(MustParse("20230301")))
+}
+
+func TestDate_Boundaries(t *testing.T) {
+	// 2023-03-01 は水曜日
+	d := MustParse("2023-03-01")
+
+	assert.Equal(t, MustParse("2023-02-27"), d.StartOfWeek())
+	assert.Equal(t, MustParse("2023-03-05"), d.EndOfWeek())
+	assert.Equal(t, MustParse("2023-03-05"), MustParse("2023-03-05").EndOfWeek())
+	assert.Equal(t, MustParse("2023-03-01"), d.StartOfMonth())
+	assert.Equal(t, MustParse("2023-03-31"), d.EndOfMonth())
+	assert.Equal(t, MustParse("2024-02-29"), MustParse("2024-02-10").EndOfMonth())
+}
+
+func TestDate_SQL(t *testing.T) {
+	d := MustParse("2023-01-02")
+	value, err := d.Value()
+	assert.NoError(t, err)
+	assert.Equal(t, int64(20230102), value)
+
+	value, err = Date{}.Value()
+	assert.NoError(t, err)
+	assert.Nil(t, value)
+
+	for _, src := range []interface{}{int64(20230102), []byte("20230102"), "20230102", time.Date(2023, 1, 2, 0, 0, 0, 0, time.UTC)} {
+		var scanned Date
+		assert.NoError(t, scanned.Scan(src))
+		assert.Equal(t, d, scanned)
+	}
+
+	var scanned Date
+	assert.NoError(t, scanned.Scan(nil))
+	assert.True(t, scanned.IsZero())
+	assert.Error(t, scanned.Scan(int64(20231301)))
+}
+
+func TestDate_JSON(t *testing.T) {
+	body, err := json.Marshal(struct {
+		Date  Date `json:"date"`
+		Empty Date `json:"empty"`
+	}{Date: MustParse("2023-01-02")})
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"date":"2023-01-02","empty":null}`, string(body))
+
+	var decoded struct {
+		A Date `json:"a"`
+		B Date `json:"b"`
+		C Date `json:"c"`
+	}
+	assert.NoError(t, json.Unmarshal([]byte(`{"a":"2023-01-02","b":20230102,"c":null}`), &decoded))
+	assert.Equal(t, MustParse("2023-01-02"), decoded.A)
+	assert.Equal(t, MustParse("2023-01-02"), decoded.B)
+	assert.True(t, decoded.C.IsZero())
+}
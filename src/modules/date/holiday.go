@@ -0,0 +1,267 @@
+package date
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+const (
+	holidayNameSubstitute = "振替休日"
+	holidayNameCitizens   = "国民の休日"
+)
+
+// Holiday は日本の祝日・休日
+type Holiday struct {
+	Date Date   `json:"date"`
+	Name string `json:"name"`
+}
+
+// holidayRule は「国民の祝日に関する法律」の祝日(From年からTo年まで。Toが0なら現在も有効)
+type holidayRule struct {
+	Name string
+	From int
+	To   int
+	Date func(year int) Date
+}
+
+// 祝日法が施行された1948年7月20日より前は祝日を返さない
+var holidayLawEnacted = New(1948, time.July, 20)
+
+var (
+	// 振替休日(1973年4月12日から)
+	substituteHolidayEnacted = New(1973, time.April, 12)
+	// 国民の休日(1985年12月27日から)
+	citizensHolidayEnacted = New(1985, time.December, 27)
+)
+
+var holidayRules = []holidayRule{
+	{Name: "元日", From: 1949, Date: fixed(time.January, 1)},
+	{Name: "成人の日", From: 1949, To: 1999, Date: fixed(time.January, 15)},
+	{Name: "成人の日", From: 2000, Date: nthMonday(time.January, 2)},
+	{Name: "建国記念の日", From: 1967, Date: fixed(time.February, 11)},
+	{Name: "天皇誕生日", From: 1949, To: 1988, Date: fixed(time.April, 29)},
+	{Name: "天皇誕生日", From: 1989, To: 2018, Date: fixed(time.December, 23)},
+	{Name: "天皇誕生日", From: 2020, Date: fixed(time.February, 23)},
+	{Name: "春分の日", From: 1949, Date: vernalEquinoxDay},
+	{Name: "みどりの日", From: 1989, To: 2006, Date: fixed(time.April, 29)},
+	{Name: "昭和の日", From: 2007, Date: fixed(time.April, 29)},
+	{Name: "憲法記念日", From: 1949, Date: fixed(time.May, 3)},
+	{Name: "みどりの日", From: 2007, Date: fixed(time.May, 4)},
+	{Name: "こどもの日", From: 1949, Date: fixed(time.May, 5)},
+	{Name: "海の日", From: 1996, To: 2002, Date: fixed(time.July, 20)},
+	{Name: "海の日", From: 2003, Date: olympicException(nthMonday(time.July, 3), map[int]Date{2020: New(2020, time.July, 23), 2021: New(2021, time.July, 22)})},
+	{Name: "山の日", From: 2016, Date: olympicException(fixed(time.August, 11), map[int]Date{2020: New(2020, time.August, 10), 2021: New(2021, time.August, 8)})},
+	{Name: "敬老の日", From: 1966, To: 2002, Date: fixed(time.September, 15)},
+	{Name: "敬老の日", From: 2003, Date: nthMonday(time.September, 3)},
+	{Name: "秋分の日", From: 1948, Date: autumnalEquinoxDay},
+	{Name: "体育の日", From: 1966, To: 1999, Date: fixed(time.October, 10)},
+	{Name: "体育の日", From: 2000, To: 2019, Date: nthMonday(time.October, 2)},
+	{Name: "スポーツの日", From: 2020, Date: olympicException(nthMonday(time.October, 2), map[int]Date{2020: New(2020, time.July, 24), 2021: New(2021, time.July, 23)})},
+	{Name: "文化の日", From: 1948, Date: fixed(time.November, 3)},
+	{Name: "勤労感謝の日", From: 1948, Date: fixed(time.November, 23)},
+}
+
+// 特別法で1回だけ休日になった日
+var specialHolidays = []Holiday{
+	{Date: New(1959, time.April, 10), Name: "皇太子明仁親王の結婚の儀"},
+	{Date: New(1989, time.February, 24), Name: "昭和天皇の大喪の礼"},
+	{Date: New(1990, time.November, 12), Name: "即位礼正殿の儀"},
+	{Date: New(1993, time.June, 9), Name: "皇太子徳仁親王の結婚の儀"},
+	{Date: New(2019, time.May, 1), Name: "天皇の即位の日"},
+	{Date: New(2019, time.October, 22), Name: "即位礼正殿の儀"},
+}
+
+func fixed(month time.Month, day int) func(int) Date {
+	return func(year int) Date {
+		return New(year, month, day)
+	}
+}
+
+// nthMonday はハッピーマンデー(その月の第n月曜日)
+func nthMonday(month time.Month, n int) func(int) Date {
+	return func(year int) Date {
+		first := New(year, month, 1)
+		offset := (int(time.Monday) - int(first.Weekday()) + 7) % 7
+		return first.AddDays(offset + (n-1)*7)
+	}
+}
+
+// olympicException は東京オリンピック・パラリンピックで移動した年だけ日付を差し替える
+func olympicException(rule func(int) Date, moved map[int]Date) func(int) Date {
+	return func(year int) Date {
+		if d, ok := moved[year]; ok {
+			return d
+		}
+		return rule(year)
+	}
+}
+
+// vernalEquinoxDay は春分日の近似式(1900〜2150年で国立天文台の暦と一致する)
+// 範囲外の年も同じ式で求める
+func vernalEquinoxDay(year int) Date {
+	switch {
+	case year < 1980:
+		return New(year, time.March, equinoxDay(20.8357, year, year-1983))
+	case year < 2100:
+		return New(year, time.March, equinoxDay(20.8431, year, year-1980))
+	}
+	return New(year, time.March, equinoxDay(21.8510, year, year-1980))
+}
+
+// autumnalEquinoxDay は秋分日の近似式
+func autumnalEquinoxDay(year int) Date {
+	switch {
+	case year < 1980:
+		return New(year, time.September, equinoxDay(23.2588, year, year-1983))
+	case year < 2100:
+		return New(year, time.September, equinoxDay(23.2488, year, year-1980))
+	}
+	return New(year, time.September, equinoxDay(24.2488, year, year-1980))
+}
+
+func equinoxDay(base float64, year int, leap int) int {
+	return int(base + 0.242194*float64(year-1980) - float64(leap/4))
+}
+
+var holidayCache = struct {
+	sync.Mutex
+	years map[int]map[Date]string
+}{years: map[int]map[Date]string{}}
+
+// holidaysOf はその年の祝日・休日(日付と名前)。年ごとに計算した結果を使い回す
+func holidaysOf(year int) map[Date]string {
+	holidayCache.Lock()
+	defer holidayCache.Unlock()
+	if holidays, ok := holidayCache.years[year]; ok {
+		return holidays
+	}
+	holidays := computeHolidays(year)
+	holidayCache.years[year] = holidays
+	return holidays
+}
+
+func computeHolidays(year int) map[Date]string {
+	// 国民の祝日(前後の年は年末年始の振替休日の判定に使う)
+	national := map[Date]string{}
+	for y := year - 1; y <= year+1; y++ {
+		for _, rule := range holidayRules {
+			if y < rule.From || (rule.To != 0 && y > rule.To) {
+				continue
+			}
+			d := rule.Date(y)
+			if d.Before(holidayLawEnacted) {
+				continue
+			}
+			national[d] = rule.Name
+		}
+		for _, h := range specialHolidays {
+			if h.Date.Year == y {
+				national[h.Date] = h.Name
+			}
+		}
+	}
+
+	holidays := map[Date]string{}
+	for d, name := range national {
+		holidays[d] = name
+	}
+
+	// 振替休日: 祝日が日曜日なら、その後の最初の祝日でない日(2006年までは翌日だけ)
+	for d := range national {
+		if d.Weekday() != time.Sunday || d.Before(substituteHolidayEnacted) {
+			continue
+		}
+		substitute := d.AddDays(1)
+		if d.Year >= 2007 {
+			for {
+				if _, ok := national[substitute]; !ok {
+					break
+				}
+				substitute = substitute.AddDays(1)
+			}
+		}
+		if _, ok := national[substitute]; !ok {
+			holidays[substitute] = holidayNameSubstitute
+		}
+	}
+
+	// 国民の休日: 前日と翌日が祝日で挟まれた平日
+	for d := range national {
+		between := d.AddDays(1)
+		if between.Before(citizensHolidayEnacted) || between.Weekday() == time.Sunday {
+			continue
+		}
+		if _, ok := holidays[between]; ok {
+			continue
+		}
+		if _, ok := national[between.AddDays(1)]; ok {
+			holidays[between] = holidayNameCitizens
+		}
+	}
+
+	for d := range holidays {
+		if d.Year != year {
+			delete(holidays, d)
+		}
+	}
+	return holidays
+}
+
+// Holidays はその年の祝日・休日を日付順に返す
+func Holidays(year int) []Holiday {
+	var holidays []Holiday
+	for d, name := range holidaysOf(year) {
+		holidays = append(holidays, Holiday{Date: d, Name: name})
+	}
+	sort.Slice(holidays, func(i, j int) bool { return holidays[i].Date.Before(holidays[j].Date) })
+	return holidays
+}
+
+// HolidayName は祝日・休日の名前。祝日でなければ ok は false
+func (d Date) HolidayName() (string, bool) {
+	name, ok := holidaysOf(d.Year)[d]
+	return name, ok
+}
+
+// IsHoliday は祝日・休日(振替休日と国民の休日を含む。土日は含まない)
+func (d Date) IsHoliday() bool {
+	_, ok := d.HolidayName()
+	return ok
+}
+
+func (d Date) IsWeekend() bool {
+	weekday := d.Weekday()
+	return weekday == time.Saturday || weekday == time.Sunday
+}
+
+// IsBusinessDay は土日祝日以外の日
+func (d Date) IsBusinessDay() bool {
+	return !d.IsWeekend() && !d.IsHoliday()
+}
+
+// AddBusinessDays は n 営業日後(負なら前)の日付。d 自身は数えない
+func (d Date) AddBusinessDays(n int) Date {
+	step := 1
+	if n < 0 {
+		step, n = -1, -n
+	}
+	for n > 0 {
+		d = d.AddDays(step)
+		if d.IsBusinessDay() {
+			n--
+		}
+	}
+	return d
+}
+
+// BusinessDays は期間内の営業日数
+func (r Range) BusinessDays() int {
+	var days int
+	for _, d := range r.Dates() {
+		if d.IsBusinessDay() {
+			days++
+		}
+	}
+	return days
+}
@@ -0,0 +1,72 @@
+package date
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestHolidayName(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		date string
+		want string
+	}{
+		{date: "2023-01-01", want: "元日"},
+		{date: "2023-01-02", want: "振替休日"},
+		{date: "2023-01-09", want: "成人の日"},
+		{date: "2024-03-20", want: "春分の日"},
+		{date: "2024-09-22", want: "秋分の日"},
+		{date: "2024-09-23", want: "振替休日"},
+		{date: "2025-09-23", want: "秋分の日"},
+		{date: "1960-03-20", want: "春分の日"},
+		{date: "1999-01-15", want: "成人の日"},
+		{date: "2009-09-22", want: "国民の休日"},
+		{date: "2026-09-22", want: "国民の休日"},
+		{date: "2019-04-30", want: "国民の休日"},
+		{date: "2019-05-01", want: "天皇の即位の日"},
+		{date: "2019-05-02", want: "国民の休日"},
+		{date: "2019-05-06", want: "振替休日"},
+		{date: "2008-05-06", want: "振替休日"},
+		{date: "2020-07-24", want: "スポーツの日"},
+		{date: "2021-08-08", want: "山の日"},
+		{date: "2021-08-09", want: "振替休日"},
+		{date: "2000-05-04", want: "国民の休日"},
+		{date: "1988-12-23", want: ""},
+		{date: "2019-12-23", want: ""},
+		{date: "1948-05-05", want: ""},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.date, func(t *testing.T) {
+			t.Parallel()
+			name, ok := MustParse(tt.date).HolidayName()
+			assert.Equal(t, tt.want, name)
+			assert.Equal(t, tt.want != "", ok)
+		})
+	}
+}
+
+func TestHolidays(t *testing.T) {
+	holidays := Holidays(2023)
+	assert.Len(t, holidays, 17)
+	assert.Equal(t, Holiday{Date: MustParse("2023-01-01"), Name: "元日"}, holidays[0])
+	assert.Equal(t, Holiday{Date: MustParse("2023-11-23"), Name: "勤労感謝の日"}, holidays[len(holidays)-1])
+
+	// 2150年までの春分日・秋分日は3月19〜21日、9月21〜24日
+	for year := 1949; year <= 2150; year++ {
+		assert.Contains(t, []int{19, 20, 21}, vernalEquinoxDay(year).Day, year)
+		assert.Contains(t, []int{21, 22, 23, 24}, autumnalEquinoxDay(year).Day, year)
+	}
+}
+
+func TestBusinessDays(t *testing.T) {
+	// 2023-05-02(火)の次の営業日はゴールデンウィーク明けの5月8日(月)
+	assert.Equal(t, MustParse("2023-05-08"), MustParse("2023-05-02").AddBusinessDays(1))
+	assert.Equal(t, MustParse("2023-05-02"), MustParse("2023-05-08").AddBusinessDays(-1))
+	assert.True(t, MustParse("2023-05-08").IsBusinessDay())
+	assert.False(t, MustParse("2023-05-06").IsBusinessDay())
+	assert.False(t, MustParse("2023-05-06").IsHoliday())
+
+	// 2023年1月の営業日は20日
+	assert.Equal(t, 20, Month(MustParse("2023-01-01")).BusinessDays())
+}
@@ -0,0 +1,84 @@
+package date
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Range は Start から End まで(両端を含む)の期間
+type Range struct {
+	Start Date `json:"start"`
+	End   Date `json:"end"`
+}
+
+// NewRange は期間を作る。End が Start より前ならエラーにする
+func NewRange(start Date, end Date) (Range, error) {
+	if end.Before(start) {
+		return Range{}, fmt.Errorf("invalid range: %v is before %v", end, start)
+	}
+	return Range{Start: start, End: end}, nil
+}
+
+// ParseRange は ISO 8601 の期間(2023-01-01/2023-01-31)を読み込む
+func ParseRange(s string) (Range, error) {
+	parts := strings.Split(s, "/")
+	if len(parts) != 2 {
+		return Range{}, fmt.Errorf("invalid range: %q", s)
+	}
+	start, err := Parse(parts[0])
+	if err != nil {
+		return Range{}, err
+	}
+	end, err := Parse(parts[1])
+	if err != nil {
+		return Range{}, err
+	}
+	return NewRange(start, end)
+}
+
+// LastDays は end までの days 日間
+func LastDays(end Date, days int) Range {
+	return Range{Start: end.AddDays(-days + 1), End: end}
+}
+
+// Week は d を含む月曜日から日曜日まで
+func Week(d Date) Range {
+	return Range{Start: d.StartOfWeek(), End: d.EndOfWeek()}
+}
+
+// Month は d を含む月
+func Month(d Date) Range {
+	return Range{Start: d.StartOfMonth(), End: d.EndOfMonth()}
+}
+
+// Days は期間の日数
+func (r Range) Days() int {
+	return r.End.Sub(r.Start) + 1
+}
+
+func (r Range) Contains(d Date) bool {
+	return !d.Before(r.Start) && !d.After(r.End)
+}
+
+// Dates は期間の日付を古い順に返す
+func (r Range) Dates() []Date {
+	dates := make([]Date, 0, r.Days())
+	for d := r.Start; !d.After(r.End); d = d.AddDays(1) {
+		dates = append(dates, d)
+	}
+	return dates
+}
+
+// Shift は期間を days 日ずらす
+func (r Range) Shift(days int) Range {
+	return Range{Start: r.Start.AddDays(days), End: r.End.AddDays(days)}
+}
+
+// Previous は同じ日数の直前の期間(前週比などの比較に使う)
+func (r Range) Previous() Range {
+	return r.Shift(-r.Days())
+}
+
+func (r Range) String() string {
+	return r.Start.String() + "/" + r.End.String()
+}
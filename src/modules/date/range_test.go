@@ -0,0 +1,44 @@
+package date
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestRange(t *testing.T) {
+	r, err := NewRange(MustParse("2023-01-30"), MustParse("2023-02-02"))
+	assert.NoError(t, err)
+
+	assert.Equal(t, 4, r.Days())
+	assert.Equal(t, []Date{MustParse("2023-01-30"), MustParse("2023-01-31"), MustParse("2023-02-01"), MustParse("2023-02-02")}, r.Dates())
+	assert.True(t, r.Contains(MustParse("2023-02-01")))
+	assert.False(t, r.Contains(MustParse("2023-02-03")))
+	assert.Equal(t, Range{Start: MustParse("2023-01-26"), End: MustParse("2023-01-29")}, r.Previous())
+	assert.Equal(t, "2023-01-30/2023-02-02", r.String())
+
+	_, err = NewRange(MustParse("2023-02-02"), MustParse("2023-01-30"))
+	assert.Error(t, err)
+}
+
+func TestLastDays(t *testing.T) {
+	r := LastDays(MustParse("2023-01-07"), 7)
+	assert.Equal(t, Range{Start: MustParse("2023-01-01"), End: MustParse("2023-01-07")}, r)
+	// 前の7日間
+	assert.Equal(t, Range{Start: MustParse("2022-12-25"), End: MustParse("2022-12-31")}, r.Previous())
+}
+
+func TestWeekAndMonth(t *testing.T) {
+	assert.Equal(t, Range{Start: MustParse("2023-02-27"), End: MustParse("2023-03-05")}, Week(MustParse("2023-03-01")))
+	assert.Equal(t, Range{Start: MustParse("2023-02-01"), End: MustParse("2023-02-28")}, Month(MustParse("2023-02-14")))
+}
+
+func TestParseRange(t *testing.T) {
+	r, err := ParseRange("20230101/2023-01-31")
+	assert.NoError(t, err)
+	assert.Equal(t, 31, r.Days())
+
+	for _, input := range []string{"2023-01-01", "2023-01-31/2023-01-01", "a/b"} {
+		_, err := ParseRange(input)
+		assert.Error(t, err, input)
+	}
+}
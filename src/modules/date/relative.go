@@ -0,0 +1,144 @@
+package date
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"time"
+)
+
+// Latest は最新のデータの日付を表すキーワード
+const Latest = "latest"
+
+var (
+	// -30d, -4w, latest-7d
+	relativeDatePattern = regexp.MustCompile(`^(?:latest)?-(\d+)([dw])$`)
+	// last_30_days, last_4_weeks
+	lastPeriodPattern = regexp.MustCompile(`^last_(\d+)_(days|weeks)$`)
+	// 2022-Q3, 2022Q3
+	quarterPattern = regexp.MustCompile(`^(\d{4})-?[Qq]([1-4])$`)
+	monthPattern   = regexp.MustCompile(`^\d{4}-\d{2}$`)
+	yearPattern    = regexp.MustCompile(`^\d{4}$`)
+)
+
+// Expr は日付の指定。絶対日付のほか latest と latest からの相対指定(-30d, -4w)を受け付ける
+type Expr struct {
+	date     Date
+	relative bool
+	days     int
+}
+
+// ParseExpr は日付の指定を読み込む。相対指定は Resolve で日付にする
+func ParseExpr(s string) (Expr, error) {
+	if s == Latest {
+		return Expr{relative: true}, nil
+	}
+	if m := relativeDatePattern.FindStringSubmatch(s); m != nil {
+		n, err := strconv.Atoi(m[1])
+		if err != nil {
+			return Expr{}, fmt.Errorf("invalid date: %q", s)
+		}
+		if m[2] == "w" {
+			n *= 7
+		}
+		return Expr{relative: true, days: -n}, nil
+	}
+	d, err := Parse(s)
+	if err != nil {
+		return Expr{}, err
+	}
+	return Expr{date: d}, nil
+}
+
+// Relative は解決に最新のデータの日付が必要か
+func (e Expr) Relative() bool {
+	return e.relative
+}
+
+// Resolve は latest(最新のデータの日付)を基準に日付を決める
+func (e Expr) Resolve(latest Date) Date {
+	if !e.relative {
+		return e.date
+	}
+	return latest.AddDays(e.days)
+}
+
+// Period は期間の指定
+// latest, last_N_days, last_N_weeks, this_week, this_month は最新のデータの日付まで、
+// 2022-Q3, 2022-09, 2022 のような暦の期間は最新のデータの日付で打ち切る
+type Period struct {
+	fixed    Range
+	relative bool
+	resolve  func(latest Date) Range
+}
+
+// ParsePeriod は期間の指定を読み込む。2023-01-01/2023-01-31 の形式の期間はそのまま使う
+func ParsePeriod(s string) (Period, error) {
+	switch s {
+	case Latest:
+		return relativePeriod(func(latest Date) Range { return LastDays(latest, 1) }), nil
+	case "this_week":
+		return relativePeriod(func(latest Date) Range { return Range{Start: latest.StartOfWeek(), End: latest} }), nil
+	case "this_month":
+		return relativePeriod(func(latest Date) Range { return Range{Start: latest.StartOfMonth(), End: latest} }), nil
+	}
+	if m := lastPeriodPattern.FindStringSubmatch(s); m != nil {
+		n, err := strconv.Atoi(m[1])
+		if err != nil || n == 0 {
+			return Period{}, fmt.Errorf("invalid period: %q", s)
+		}
+		if m[2] == "weeks" {
+			n *= 7
+		}
+		return relativePeriod(func(latest Date) Range { return LastDays(latest, n) }), nil
+	}
+
+	var calendar Range
+	switch {
+	case quarterPattern.MatchString(s):
+		m := quarterPattern.FindStringSubmatch(s)
+		year, _ := strconv.Atoi(m[1])
+		quarter, _ := strconv.Atoi(m[2])
+		start := New(year, time.Month(quarter*3-2), 1)
+		calendar = Range{Start: start, End: New(year, time.Month(quarter*3+1), 0)}
+	case monthPattern.MatchString(s):
+		t, err := time.Parse("2006-01", s)
+		if err != nil {
+			return Period{}, fmt.Errorf("invalid period: %q", s)
+		}
+		calendar = Month(Of(t))
+	case yearPattern.MatchString(s):
+		year, _ := strconv.Atoi(s)
+		calendar = Range{Start: New(year, time.January, 1), End: New(year, time.December, 31)}
+	default:
+		r, err := ParseRange(s)
+		if err != nil {
+			return Period{}, fmt.Errorf("invalid period: %q", s)
+		}
+		return Period{fixed: r}, nil
+	}
+	return relativePeriod(func(latest Date) Range {
+		if latest.Before(calendar.End) {
+			return Range{Start: calendar.Start, End: latest}
+		}
+		return calendar
+	}), nil
+}
+
+func relativePeriod(resolve func(latest Date) Range) Period {
+	return Period{relative: true, resolve: resolve}
+}
+
+// Relative は解決に最新のデータの日付が必要か
+func (p Period) Relative() bool {
+	return p.relative
+}
+
+// Resolve は latest(最新のデータの日付)を基準に期間を決める
+// 暦の期間が latest より後に始まる場合は End が Start より前になる
+func (p Period) Resolve(latest Date) Range {
+	if !p.relative {
+		return p.fixed
+	}
+	return p.resolve(latest)
+}
@@ -0,0 +1,76 @@
+package date
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestParseExpr(t *testing.T) {
+	t.Parallel()
+	latest := MustParse("2023-05-10")
+	tests := []struct {
+		input    string
+		want     Date
+		relative bool
+	}{
+		{input: "latest", want: latest, relative: true},
+		{input: "-30d", want: MustParse("2023-04-10"), relative: true},
+		{input: "-4w", want: MustParse("2023-04-12"), relative: true},
+		{input: "latest-1d", want: MustParse("2023-05-09"), relative: true},
+		{input: "20230101", want: MustParse("2023-01-01")},
+		{input: "2023-01-01", want: MustParse("2023-01-01")},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.input, func(t *testing.T) {
+			t.Parallel()
+			e, err := ParseExpr(tt.input)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.relative, e.Relative())
+			assert.Equal(t, tt.want, e.Resolve(latest))
+		})
+	}
+
+	for _, input := range []string{"", "30d", "-30", "-d", "latest+1d", "abc"} {
+		_, err := ParseExpr(input)
+		assert.Error(t, err, input)
+	}
+}
+
+func TestParsePeriod(t *testing.T) {
+	t.Parallel()
+	latest := MustParse("2023-05-10")
+	tests := []struct {
+		input    string
+		want     Range
+		relative bool
+	}{
+		{input: "latest", want: Range{Start: latest, End: latest}, relative: true},
+		{input: "last_4_weeks", want: Range{Start: MustParse("2023-04-13"), End: latest}, relative: true},
+		{input: "last_7_days", want: Range{Start: MustParse("2023-05-04"), End: latest}, relative: true},
+		{input: "this_week", want: Range{Start: MustParse("2023-05-08"), End: latest}, relative: true},
+		{input: "this_month", want: Range{Start: MustParse("2023-05-01"), End: latest}, relative: true},
+		{input: "2022-Q3", want: Range{Start: MustParse("2022-07-01"), End: MustParse("2022-09-30")}, relative: true},
+		{input: "2022Q4", want: Range{Start: MustParse("2022-10-01"), End: MustParse("2022-12-31")}, relative: true},
+		// 最新のデータの日付で打ち切る
+		{input: "2023-Q2", want: Range{Start: MustParse("2023-04-01"), End: latest}, relative: true},
+		{input: "2022-02", want: Range{Start: MustParse("2022-02-01"), End: MustParse("2022-02-28")}, relative: true},
+		{input: "2022", want: Range{Start: MustParse("2022-01-01"), End: MustParse("2022-12-31")}, relative: true},
+		{input: "2023-01-01/2023-01-31", want: Range{Start: MustParse("2023-01-01"), End: MustParse("2023-01-31")}},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.input, func(t *testing.T) {
+			t.Parallel()
+			p, err := ParsePeriod(tt.input)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.relative, p.Relative())
+			assert.Equal(t, tt.want, p.Resolve(latest))
+		})
+	}
+
+	for _, input := range []string{"", "last_0_days", "last_4_months", "2022-Q5", "2022-13", "2023-01-01"} {
+		_, err := ParsePeriod(input)
+		assert.Error(t, err, input)
+	}
+}
@@ -0,0 +1,133 @@
+package delivery
+
+import (
+	"context"
+	"corona-api/src/middleware"
+	"corona-api/src/modules/notification"
+	"database/sql"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// 配信に共通の状態(送れなかった配信の状態は Policy で決める)
+const (
+	StatusPending   = "pending"
+	StatusSucceeded = "succeeded"
+)
+
+// Policy は再送の設定と配信を記録するテーブル
+// Table は id, status, attempts, response_status, error_message, next_attempt_at, delivered_at の列を持つ
+type Policy struct {
+	Table       string
+	MaxAttempts uint32
+	BaseBackoff time.Duration
+	MaxBackoff  time.Duration
+	// 配信中の配信を他の処理が取得しないようにする時間
+	Lease time.Duration
+	// FailedStatus は再送しない失敗と、再送の上限に達した配信の状態
+	FailedStatus string
+}
+
+// Backoff は attempts 回目の失敗後に次の配信まで待つ時間
+func (p Policy) Backoff(attempts uint32) time.Duration {
+	backoff := p.BaseBackoff
+	for i := uint32(1); i < attempts; i++ {
+		backoff *= 2
+		if backoff >= p.MaxBackoff {
+			return p.MaxBackoff
+		}
+	}
+	return backoff
+}
+
+// Due は期限が来た配信
+type Due struct {
+	ID       int64
+	Attempts uint32
+	Event    string
+	Payload  string
+	URL      string
+	Secret   string
+}
+
+// Sender は期限が来た配信を署名付きで送り、結果を記録する
+type Sender struct {
+	Policy Policy
+	Client *http.Client
+	Now    func() time.Time
+}
+
+// Deliver は他の処理が配信中ではない配信を送り、成功した件数を返す
+func (s Sender) Deliver(ctx context.Context, q middleware.Querier, dues []Due) (int, error) {
+	now := s.Now().UTC().Truncate(time.Second)
+	succeeded := 0
+	for _, due := range dues {
+		claimed, err := s.claim(ctx, q, due.ID, now)
+		if err != nil {
+			return succeeded, err
+		}
+		if !claimed {
+			continue
+		}
+
+		status, sendErr := s.Send(ctx, due)
+		if err := s.record(ctx, q, due, status, sendErr); err != nil {
+			return succeeded, err
+		}
+		if sendErr == nil {
+			succeeded++
+		}
+	}
+	return succeeded, nil
+}
+
+// claim は同時に実行された他の処理と二重に送らないよう、配信中の期限を延ばせた場合だけ true を返す
+func (s Sender) claim(ctx context.Context, q middleware.Querier, id int64, now time.Time) (bool, error) {
+	res, err := q.ExecContext(ctx, fmt.Sprintf("UPDATE %s SET next_attempt_at = ? WHERE id = ? AND status = ? AND next_attempt_at <= ?", s.Policy.Table),
+		now.Add(s.Policy.Lease), id, StatusPending, now)
+	if err != nil {
+		return false, fmt.Errorf("update %s error: %v", s.Policy.Table, err)
+	}
+	claimed, err := res.RowsAffected()
+	if err != nil {
+		return false, nil
+	}
+	return claimed > 0, nil
+}
+
+// Send は署名付きで配信し、レスポンスのステータスを返す
+func (s Sender) Send(ctx context.Context, due Due) (int, error) {
+	header := http.Header{}
+	header.Set(notification.WebhookDeliveryHeader, strconv.FormatInt(due.ID, 10))
+	header.Set(notification.WebhookEventHeader, due.Event)
+	return notification.PostSigned(ctx, s.Client, due.URL, due.Secret, s.Now(), header, []byte(due.Payload))
+}
+
+// record は配信結果を記録する。失敗した場合は再送の時刻を設定し、再送しない失敗や上限に達した場合は FailedStatus にする
+func (s Sender) record(ctx context.Context, q middleware.Querier, due Due, status int, sendErr error) error {
+	now := s.Now().UTC().Truncate(time.Second)
+	attempts := due.Attempts + 1
+	var responseStatus sql.NullInt64
+	if status != 0 {
+		responseStatus = sql.NullInt64{Int64: int64(status), Valid: true}
+	}
+
+	var err error
+	switch {
+	case sendErr == nil:
+		_, err = q.ExecContext(ctx, fmt.Sprintf("UPDATE %s SET status = ?, attempts = ?, response_status = ?, error_message = NULL, next_attempt_at = NULL, delivered_at = ? WHERE id = ?", s.Policy.Table),
+			StatusSucceeded, attempts, responseStatus, now, due.ID)
+	case notification.Retryable(status) && attempts < s.Policy.MaxAttempts:
+		_, err = q.ExecContext(ctx, fmt.Sprintf("UPDATE %s SET attempts = ?, response_status = ?, error_message = ?, next_attempt_at = ? WHERE id = ?", s.Policy.Table),
+			attempts, responseStatus, sendErr.Error(), now.Add(s.Policy.Backoff(attempts)), due.ID)
+	default:
+		_, err = q.ExecContext(ctx, fmt.Sprintf("UPDATE %s SET status = ?, attempts = ?, response_status = ?, error_message = ?, next_attempt_at = NULL WHERE id = ?", s.Policy.Table),
+			s.Policy.FailedStatus, attempts, responseStatus, sendErr.Error(), due.ID)
+	}
+	if err != nil {
+		return fmt.Errorf("update %s error: %v", s.Policy.Table, err)
+	}
+	return nil
+}
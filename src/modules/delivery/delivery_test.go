@@ -0,0 +1,60 @@
+package delivery
+
+import (
+	"context"
+	"corona-api/src/modules/notification"
+	"github.com/stretchr/testify/assert"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestPolicy_Backoff(t *testing.T) {
+	p := Policy{BaseBackoff: time.Minute, MaxBackoff: time.Hour}
+	tests := []struct {
+		attempts uint32
+		want     time.Duration
+	}{
+		{1, time.Minute},
+		{2, 2 * time.Minute},
+		{3, 4 * time.Minute},
+		{6, 32 * time.Minute},
+		{7, time.Hour},
+		{20, time.Hour},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(strconv.Itoa(int(tt.attempts)), func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.want, p.Backoff(tt.attempts))
+		})
+	}
+}
+
+func TestSender_Send(t *testing.T) {
+	now := time.Date(2023, 1, 14, 9, 0, 0, 0, time.UTC)
+	payload := `{"event":"alert.triggered"}`
+
+	var header http.Header
+	var body []byte
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		header = r.Header
+		body, _ = io.ReadAll(r.Body)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	s := Sender{Client: server.Client(), Now: func() time.Time { return now }}
+	status, err := s.Send(context.Background(), Due{ID: 7, Event: "alert.triggered", Payload: payload, URL: server.URL, Secret: "secret"})
+	assert.Error(t, err)
+	assert.Equal(t, http.StatusServiceUnavailable, status)
+
+	assert.Equal(t, payload, string(body))
+	assert.Equal(t, "7", header.Get(notification.WebhookDeliveryHeader))
+	assert.Equal(t, "alert.triggered", header.Get(notification.WebhookEventHeader))
+	assert.Equal(t, strconv.FormatInt(now.Unix(), 10), header.Get(notification.WebhookTimestampHeader))
+	assert.True(t, notification.VerifySignature("secret", now.Unix(), body, header.Get(notification.WebhookSignatureHeader)))
+}
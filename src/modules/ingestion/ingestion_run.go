@@ -0,0 +1,117 @@
+package ingestion
+
+import (
+	"context"
+	"corona-api/src/middleware"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"time"
+)
+
+const (
+	RunStatusRunning   = "running"
+	RunStatusSucceeded = "succeeded"
+	RunStatusFailed    = "failed"
+)
+
+var ErrRunNotFound = errors.New("ingestion run not found")
+
+// Run は patient_details の取り込み(定期実行)1回分の履歴
+type Run struct {
+	ID           int64      `json:"id"`
+	ObjectKey    string     `json:"object_key"`
+	ContentHash  string     `json:"content_hash"`
+	Status       string     `json:"status"`
+	RowsRead     uint32     `json:"rows_read"`
+	RowsDeleted  uint32     `json:"rows_deleted"`
+	RowsInserted uint32     `json:"rows_inserted"`
+	ErrorMessage string     `json:"error_message,omitempty"`
+	StartedAt    time.Time  `json:"started_at"`
+	FinishedAt   *time.Time `json:"finished_at"`
+}
+
+func ContentHash(file []byte) string {
+	sum := sha256.Sum256(file)
+	return hex.EncodeToString(sum[:])
+}
+
+// StartRun は取り込み開始を記録する
+func StartRun(ctx context.Context, q middleware.Querier, objectKey string) (*Run, error) {
+	run := &Run{
+		ObjectKey: objectKey,
+		Status:    RunStatusRunning,
+		StartedAt: time.Now().UTC().Truncate(time.Second),
+	}
+	res, err := q.ExecContext(ctx, "INSERT INTO ingestion_runs (object_key, status, started_at) VALUES (?,?,?)", run.ObjectKey, run.Status, run.StartedAt)
+	if err != nil {
+		return nil, fmt.Errorf("insert ingestion_runs error: %v", err)
+	}
+	run.ID, err = res.LastInsertId()
+	if err != nil {
+		return nil, fmt.Errorf("res.LastInsertId() error: %v", err)
+	}
+	return run, nil
+}
+
+// FinishRun は取り込み結果を記録する。runErr が nil でなければ失敗として記録する
+func FinishRun(ctx context.Context, q middleware.Querier, run *Run, runErr error) error {
+	finishedAt := time.Now().UTC().Truncate(time.Second)
+	run.FinishedAt = &finishedAt
+	run.Status = RunStatusSucceeded
+	var errorMessage sql.NullString
+	if runErr != nil {
+		run.Status = RunStatusFailed
+		run.ErrorMessage = runErr.Error()
+		errorMessage = sql.NullString{String: run.ErrorMessage, Valid: true}
+	}
+	_, err := q.ExecContext(ctx, "UPDATE ingestion_runs SET content_hash = ?, status = ?, rows_read = ?, rows_deleted = ?, rows_inserted = ?, error_message = ?, finished_at = ? WHERE id = ?",
+		run.ContentHash, run.Status, run.RowsRead, run.RowsDeleted, run.RowsInserted, errorMessage, finishedAt, run.ID)
+	if err != nil {
+		return fmt.Errorf("update ingestion_runs error: %v", err)
+	}
+	return nil
+}
+
+// GetLastSuccessfulRun は最後に成功した取り込みを取得する
+func GetLastSuccessfulRun(ctx context.Context, q middleware.Querier) (Run, error) {
+	row := q.QueryRowContext(ctx, "SELECT id, object_key, content_hash, status, rows_read, rows_deleted, rows_inserted, error_message, started_at, finished_at FROM ingestion_runs WHERE status = ? ORDER BY finished_at DESC, id DESC LIMIT 1", RunStatusSucceeded)
+	run, err := scanRun(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		return Run{}, ErrRunNotFound
+	}
+	if err != nil {
+		return Run{}, fmt.Errorf("row.Scan() error: %v", err)
+	}
+	return run, nil
+}
+
+// GetRun は取り込み履歴を ID で取得する
+func GetRun(ctx context.Context, q middleware.Querier, id int64) (Run, error) {
+	row := q.QueryRowContext(ctx, "SELECT id, object_key, content_hash, status, rows_read, rows_deleted, rows_inserted, error_message, started_at, finished_at FROM ingestion_runs WHERE id = ?", id)
+	run, err := scanRun(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		return Run{}, ErrRunNotFound
+	}
+	if err != nil {
+		return Run{}, fmt.Errorf("row.Scan() error: %v", err)
+	}
+	return run, nil
+}
+
+func scanRun(row *sql.Row) (Run, error) {
+	var run Run
+	var errorMessage sql.NullString
+	var finishedAt sql.NullTime
+	err := row.Scan(&run.ID, &run.ObjectKey, &run.ContentHash, &run.Status, &run.RowsRead, &run.RowsDeleted, &run.RowsInserted, &errorMessage, &run.StartedAt, &finishedAt)
+	if err != nil {
+		return Run{}, err
+	}
+	run.ErrorMessage = errorMessage.String
+	if finishedAt.Valid {
+		run.FinishedAt = &finishedAt.Time
+	}
+	return run, nil
+}
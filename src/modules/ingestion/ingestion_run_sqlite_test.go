@@ -0,0 +1,84 @@
+//go:build cgo
+// +build cgo
+
+package ingestion
+
+import (
+	"context"
+	"corona-api/src/middleware"
+	"corona-api/src/modules/migration"
+	"database/sql"
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func openIngestionDB(t *testing.T) *sql.DB {
+	db, err := sql.Open(middleware.DriverSQLite, filepath.Join(t.TempDir(), "corona.db"))
+	assert.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+	migrations, err := migration.Load(filepath.Join("..", "..", "..", migration.SQLiteDir))
+	assert.NoError(t, err)
+	_, err = migration.Apply(context.Background(), db, migrations)
+	assert.NoError(t, err)
+	return db
+}
+
+func TestRun_SQLite(t *testing.T) {
+	db := openIngestionDB(t)
+	ctx := context.Background()
+
+	_, err := GetLastSuccessfulRun(ctx, db)
+	assert.Equal(t, ErrRunNotFound, err)
+
+	// 成功した取り込み
+	succeeded, err := StartRun(ctx, db, "patient_details/20230101.csv")
+	assert.NoError(t, err)
+	assert.Equal(t, RunStatusRunning, succeeded.Status)
+	succeeded.ContentHash = ContentHash([]byte("file"))
+	succeeded.RowsRead, succeeded.RowsDeleted, succeeded.RowsInserted = 47, 47, 47
+	assert.NoError(t, FinishRun(ctx, db, succeeded, nil))
+	assert.Equal(t, RunStatusSucceeded, succeeded.Status)
+
+	got, err := GetRun(ctx, db, succeeded.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, RunStatusSucceeded, got.Status)
+	assert.Equal(t, succeeded.ContentHash, got.ContentHash)
+	assert.Equal(t, uint32(47), got.RowsInserted)
+	assert.Empty(t, got.ErrorMessage)
+	assert.True(t, succeeded.FinishedAt.Equal(*got.FinishedAt))
+
+	// より新しい失敗した取り込みと、終わっていない取り込み
+	failed, err := StartRun(ctx, db, "patient_details/20230102.csv")
+	assert.NoError(t, err)
+	assert.NoError(t, FinishRun(ctx, db, failed, errors.New("download error")))
+	_, err = db.Exec("UPDATE ingestion_runs SET finished_at = ? WHERE id = ?", succeeded.FinishedAt.Add(time.Hour), failed.ID)
+	assert.NoError(t, err)
+	_, err = StartRun(ctx, db, "patient_details/20230103.csv")
+	assert.NoError(t, err)
+
+	got, err = GetRun(ctx, db, failed.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, RunStatusFailed, got.Status)
+	assert.Equal(t, "download error", got.ErrorMessage)
+
+	// 最後に成功した取り込みは新しい失敗より優先する
+	last, err := GetLastSuccessfulRun(ctx, db)
+	assert.NoError(t, err)
+	assert.Equal(t, succeeded.ID, last.ID)
+
+	// 成功した取り込みの中では完了日時が新しいもの
+	older, err := StartRun(ctx, db, "patient_details/20221231.csv")
+	assert.NoError(t, err)
+	assert.NoError(t, FinishRun(ctx, db, older, nil))
+	_, err = db.Exec("UPDATE ingestion_runs SET finished_at = ? WHERE id = ?", succeeded.FinishedAt.Add(-time.Hour), older.ID)
+	assert.NoError(t, err)
+	last, err = GetLastSuccessfulRun(ctx, db)
+	assert.NoError(t, err)
+	assert.Equal(t, succeeded.ID, last.ID)
+
+	_, err = GetRun(ctx, db, 999)
+	assert.Equal(t, ErrRunNotFound, err)
+}
@@ -0,0 +1,180 @@
+package migration
+
+import (
+	"context"
+	"corona-api/src/middleware"
+	"database/sql"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	MySQLDir  = "database/migrations/mysql"
+	SQLiteDir = "database/migrations/sqlite"
+	// TableName は適用済みのマイグレーションを記録するテーブル
+	TableName = "schema_migrations"
+)
+
+// Dir はドライバーのマイグレーションのディレクトリ
+func Dir(driver string) string {
+	if driver == middleware.DriverSQLite {
+		return SQLiteDir
+	}
+	return MySQLDir
+}
+
+// Migration は 000001_create_patient_details.sql のような番号付きのSQLファイル
+type Migration struct {
+	Version uint64
+	Name    string
+	SQL     string
+}
+
+// Load はディレクトリのマイグレーションを番号順に読み込む
+func Load(dir string) ([]Migration, error) {
+	paths, err := filepath.Glob(filepath.Join(dir, "*.sql"))
+	if err != nil {
+		return nil, fmt.Errorf("filepath.Glob() error: %v", err)
+	}
+
+	var migrations []Migration
+	versions := map[uint64]string{}
+	for _, path := range paths {
+		name := strings.TrimSuffix(filepath.Base(path), ".sql")
+		version, err := parseVersion(name)
+		if err != nil {
+			return nil, err
+		}
+		if other, ok := versions[version]; ok {
+			return nil, fmt.Errorf("duplicate migration version: %v, %v", other, name)
+		}
+		versions[version] = name
+
+		body, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("os.ReadFile() error: %v", err)
+		}
+		migrations = append(migrations, Migration{Version: version, Name: name, SQL: string(body)})
+	}
+	sort.Slice(migrations, func(i, j int) bool {
+		return migrations[i].Version < migrations[j].Version
+	})
+	return migrations, nil
+}
+
+func parseVersion(name string) (uint64, error) {
+	prefix := strings.SplitN(name, "_", 2)[0]
+	version, err := strconv.ParseUint(prefix, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid migration file name: %v", name)
+	}
+	return version, nil
+}
+
+// Statements はSQLファイルを文ごとに分ける(ドライバーは複数の文を一度に実行できない)
+func (m Migration) Statements() []string {
+	var statements []string
+	var current strings.Builder
+	var quote rune
+	runes := []rune(m.SQL)
+	for i := 0; i < len(runes); i++ {
+		r := runes[i]
+		switch {
+		case quote != 0:
+			if r == quote {
+				quote = 0
+			}
+		case r == '\'' || r == '"' || r == '`':
+			quote = r
+		case r == '-' && i+1 < len(runes) && runes[i+1] == '-':
+			// 行末までのコメントは読み飛ばす
+			for i < len(runes) && runes[i] != '\n' {
+				i++
+			}
+			current.WriteRune('\n')
+			continue
+		case r == ';':
+			if statement := strings.TrimSpace(current.String()); statement != "" {
+				statements = append(statements, statement)
+			}
+			current.Reset()
+			continue
+		}
+		current.WriteRune(r)
+	}
+	if statement := strings.TrimSpace(current.String()); statement != "" {
+		statements = append(statements, statement)
+	}
+	return statements
+}
+
+// Applied は適用済みのバージョンを取得する
+func Applied(ctx context.Context, db *sql.DB) (map[uint64]bool, error) {
+	_, err := db.ExecContext(ctx, "CREATE TABLE IF NOT EXISTS "+TableName+" (version BIGINT UNSIGNED NOT NULL, name VARCHAR(255) NOT NULL, applied_at DATETIME NOT NULL, PRIMARY KEY (version))")
+	if err != nil {
+		return nil, fmt.Errorf("create %v error: %v", TableName, err)
+	}
+
+	rows, err := db.QueryContext(ctx, "SELECT version FROM "+TableName)
+	if err != nil {
+		return nil, fmt.Errorf("db.QueryContext() error: %v", err)
+	}
+	defer rows.Close()
+
+	applied := map[uint64]bool{}
+	for rows.Next() {
+		var version uint64
+		if err := rows.Scan(&version); err != nil {
+			return nil, fmt.Errorf("rows.Scan() error: %v", err)
+		}
+		applied[version] = true
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows.Err() error: %v", err)
+	}
+	return applied, nil
+}
+
+// Pending は未適用のマイグレーションを返す
+func Pending(ctx context.Context, db *sql.DB, migrations []Migration) ([]Migration, error) {
+	applied, err := Applied(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+	var pending []Migration
+	for _, m := range migrations {
+		if !applied[m.Version] {
+			pending = append(pending, m)
+		}
+	}
+	return pending, nil
+}
+
+// Apply は未適用のマイグレーションを番号順に適用し、適用したものを返す
+// MySQL の DDL はトランザクションで巻き戻せないため、途中で失敗した場合はそこで止める
+func Apply(ctx context.Context, db *sql.DB, migrations []Migration) ([]Migration, error) {
+	pending, err := Pending(ctx, db, migrations)
+	if err != nil {
+		return nil, err
+	}
+
+	var applied []Migration
+	for _, m := range pending {
+		for _, statement := range m.Statements() {
+			if _, err := db.ExecContext(ctx, statement); err != nil {
+				return applied, fmt.Errorf("migration %v error: %v", m.Name, err)
+			}
+		}
+		_, err := db.ExecContext(ctx, "INSERT INTO "+TableName+" (version, name, applied_at) VALUES (?,?,?)", m.Version, m.Name, time.Now().UTC().Truncate(time.Second))
+		if err != nil {
+			return applied, fmt.Errorf("insert %v error: %v", TableName, err)
+		}
+		applied = append(applied, m)
+	}
+	return applied, nil
+}
@@ -0,0 +1,31 @@
+//go:build cgo
+// +build cgo
+
+package migration
+
+import (
+	"context"
+	"corona-api/src/middleware"
+	"database/sql"
+	"github.com/stretchr/testify/assert"
+	"path/filepath"
+	"testing"
+)
+
+func TestApply_SQLite(t *testing.T) {
+	db, err := sql.Open(middleware.DriverSQLite, filepath.Join(t.TempDir(), "corona.db"))
+	assert.NoError(t, err)
+	defer db.Close()
+	migrations, err := Load(filepath.Join("..", "..", "..", SQLiteDir))
+	assert.NoError(t, err)
+
+	ctx := context.Background()
+	applied, err := Apply(ctx, db, migrations)
+	assert.NoError(t, err)
+	assert.Equal(t, len(migrations), len(applied))
+
+	// 適用済みのものは再び適用しない
+	applied, err = Apply(ctx, db, migrations)
+	assert.NoError(t, err)
+	assert.Empty(t, applied)
+}
@@ -0,0 +1,66 @@
+package migration
+
+import (
+	"github.com/stretchr/testify/assert"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestMigration_Statements(t *testing.T) {
+	m := Migration{SQL: `CREATE TABLE a (
+    id INT NOT NULL COMMENT 'a;b'
+);
+
+-- コメント; は区切りにしない
+CREATE TABLE b (id INT NOT NULL);
+`}
+
+	assert.Equal(t, []string{
+		"CREATE TABLE a (\n    id INT NOT NULL COMMENT 'a;b'\n)",
+		"CREATE TABLE b (id INT NOT NULL)",
+	}, m.Statements())
+}
+
+func TestLoad(t *testing.T) {
+	dir := t.TempDir()
+	for name, body := range map[string]string{
+		"000002_create_b.sql": "CREATE TABLE b (id INT);",
+		"000001_create_a.sql": "CREATE TABLE a (id INT);",
+		"README.md":           "",
+	} {
+		assert.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(body), 0o644))
+	}
+
+	migrations, err := Load(dir)
+	assert.NoError(t, err)
+	assert.Equal(t, []Migration{
+		{Version: 1, Name: "000001_create_a", SQL: "CREATE TABLE a (id INT);"},
+		{Version: 2, Name: "000002_create_b", SQL: "CREATE TABLE b (id INT);"},
+	}, migrations)
+}
+
+func TestLoad_InvalidName(t *testing.T) {
+	dir := t.TempDir()
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "create_a.sql"), []byte(""), 0o644))
+
+	_, err := Load(dir)
+	assert.Error(t, err)
+}
+
+func TestLoad_Repository(t *testing.T) {
+	mysqlMigrations, err := Load(filepath.Join("..", "..", "..", MySQLDir))
+	assert.NoError(t, err)
+	assert.NotEmpty(t, mysqlMigrations)
+	for _, m := range mysqlMigrations {
+		assert.NotEmpty(t, m.Statements(), m.Name)
+	}
+
+	// SQLite のマイグレーションは MySQL と同じ番号で揃える
+	sqliteMigrations, err := Load(filepath.Join("..", "..", "..", SQLiteDir))
+	assert.NoError(t, err)
+	assert.Equal(t, len(mysqlMigrations), len(sqliteMigrations))
+	for i := range sqliteMigrations {
+		assert.Equal(t, mysqlMigrations[i].Name, sqliteMigrations[i].Name)
+	}
+}
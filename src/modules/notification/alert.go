@@ -0,0 +1,54 @@
+package notification
+
+import (
+	"corona-api/src/modules/alert"
+	"corona-api/src/modules/date"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+const (
+	EventPatientDetailsAlert = "patient_details.alert"
+)
+
+// NewAlertMessage は発火したアラートの通知
+func NewAlertMessage(alerts []alert.Alert) Message {
+	var lines []string
+	var latestDate date.Date
+	for _, a := range alerts {
+		lines = append(lines, alertLine(a))
+		if a.Date.After(latestDate) {
+			latestDate = a.Date
+		}
+	}
+
+	fields := []Field{{"件数", strconv.Itoa(len(alerts))}}
+	if !latestDate.IsZero() {
+		fields = append([]Field{{"最新日付", latestDate.String()}}, fields...)
+	}
+	return Message{
+		Event:  EventPatientDetailsAlert,
+		Level:  LevelAlert,
+		Title:  "感染者数の増加を検知しました",
+		Fields: fields,
+		Detail: strings.Join(lines, "\n"),
+	}
+}
+
+func alertLine(a alert.Alert) string {
+	average := fmt.Sprintf("7日間平均 %.1f人(前週 %.1f人", a.Average, a.PreviousAverage)
+	if a.PreviousAverage != 0 {
+		average += fmt.Sprintf("、前週比 %+.1f%%", a.Change)
+	}
+	average += ")"
+
+	var condition string
+	switch a.Kind {
+	case alert.RuleKindWeekOverWeek:
+		condition = fmt.Sprintf("前週比 %+.1f%% 超", a.Threshold)
+	case alert.RuleKindLevel:
+		condition = fmt.Sprintf("7日間平均 %.1f人 以上", a.Threshold)
+	}
+	return fmt.Sprintf("%s: %s [%s: %s]", a.Area, average, a.RuleName, condition)
+}
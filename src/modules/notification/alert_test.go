@@ -0,0 +1,21 @@
+package notification
+
+import (
+	"corona-api/src/modules/alert"
+	"corona-api/src/modules/date"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestNewAlertMessage(t *testing.T) {
+	m := NewAlertMessage([]alert.Alert{
+		{RuleName: "急増", Kind: alert.RuleKindWeekOverWeek, Threshold: 30, Area: "東京都", Date: date.MustParse("20230114"), Average: 150, PreviousAverage: 100, Change: 50},
+		{RuleName: "高水準", Kind: alert.RuleKindLevel, Threshold: 10, Area: "北海道", Date: date.MustParse("20230114"), Average: 10},
+	})
+
+	assert.Equal(t, EventPatientDetailsAlert, m.Event)
+	assert.Equal(t, LevelAlert, m.Level)
+	assert.Equal(t, []Field{{"最新日付", "2023-01-14"}, {"件数", "2"}}, m.Fields)
+	assert.Equal(t, "東京都: 7日間平均 150.0人(前週 100.0人、前週比 +50.0%) [急増: 前週比 +30.0% 超]\n"+
+		"北海道: 7日間平均 10.0人(前週 0.0人) [高水準: 7日間平均 10.0人 以上]", m.Detail)
+}
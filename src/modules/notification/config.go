@@ -0,0 +1,69 @@
+package notification
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"os"
+)
+
+const (
+	// 通知先の一覧(Channel の JSON 配列)を保存しているパラメータ名の環境変数
+	ChannelsSettingEnv = "NOTIFICATION_CHANNELS_SETTING"
+	// ローカル環境で通知先の一覧を直接指定する環境変数
+	ChannelsEnv = "NOTIFICATION_CHANNELS"
+	// 通知先の一覧を設定していない環境で使う Slack の Webhook URL のパラメータ名
+	LegacySlackWebhookURLParameter = "NotifyExecutionOfPatientDetailsWebhookUrl"
+)
+
+// LoadChannels は通知先の一覧を取得する
+func LoadChannels() ([]Channel, error) {
+	// ローカル環境は環境変数から取得
+	if os.Getenv("GO_ENV") == "local" {
+		return ParseChannels([]byte(os.Getenv(ChannelsEnv)))
+	}
+
+	// パラメータストア接続
+	svc := ssm.New(
+		session.Must(session.NewSession()),
+		aws.NewConfig().WithRegion(os.Getenv("REGION")),
+	)
+
+	name := os.Getenv(ChannelsSettingEnv)
+	if name == "" {
+		value, err := getParameter(svc, LegacySlackWebhookURLParameter)
+		if err != nil {
+			return nil, err
+		}
+		return []Channel{{Type: ChannelTypeSlack, URL: value}}, nil
+	}
+	value, err := getParameter(svc, name)
+	if err != nil {
+		return nil, err
+	}
+	return ParseChannels([]byte(value))
+}
+
+func ParseChannels(data []byte) ([]Channel, error) {
+	if len(data) == 0 {
+		return nil, nil
+	}
+	var channels []Channel
+	if err := json.Unmarshal(data, &channels); err != nil {
+		return nil, fmt.Errorf("json.Unmarshal() error: %v", err)
+	}
+	return channels, nil
+}
+
+func getParameter(svc *ssm.SSM, name string) (string, error) {
+	res, err := svc.GetParameter(&ssm.GetParameterInput{
+		Name:           aws.String(name),
+		WithDecryption: aws.Bool(true),
+	})
+	if err != nil {
+		return "", fmt.Errorf("svc.GetParameter() error: %w", err)
+	}
+	return *res.Parameter.Value, nil
+}
@@ -0,0 +1,82 @@
+package notification
+
+import (
+	"corona-api/src/modules/ingestion"
+	"corona-api/src/modules/patient"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+const (
+	EventPatientDetailsDigest = "patient_details.digest"
+)
+
+// Digest は定期実行の成功時に送る日次のまとめ
+type Digest struct {
+	Run         ingestion.Run
+	Summary     patient.Digest
+	ExecutionID string
+}
+
+func (d Digest) Message() Message {
+	fields := nonEmptyFields(
+		Field{"最新日付", d.Summary.LatestDate.String()},
+		Field{"全国(直近7日間)", d.weekTotal()},
+		Field{"取り込み件数", formatNumber(int64(d.Run.RowsRead))},
+		Field{"変更件数", d.rowsChanged()},
+		Field{"取り込み履歴ID", runID(d.Run.ID)},
+		Field{"実行ID", d.ExecutionID},
+	)
+
+	var lines []string
+	for i, area := range d.Summary.TopAreas {
+		lines = append(lines, fmt.Sprintf("%d. %s %s人", i+1, area.Area, formatNumber(int64(area.Value))))
+	}
+	detail := ""
+	if len(lines) > 0 {
+		detail = fmt.Sprintf("感染者数の多い都道府県(%s)\n%s", d.Summary.LatestDate, strings.Join(lines, "\n"))
+	}
+
+	return Message{
+		Event:  EventPatientDetailsDigest,
+		Level:  LevelInfo,
+		Title:  "感染者数を更新しました",
+		Fields: fields,
+		Detail: detail,
+	}
+}
+
+// weekTotal は直近7日間の合計と前週比
+func (d Digest) weekTotal() string {
+	total := formatNumber(int64(d.Summary.WeekTotal)) + "人"
+	ratio, ok := d.Summary.WeekOverWeek()
+	if !ok {
+		return total
+	}
+	return fmt.Sprintf("%s(前週 %s人、前週比 %+.1f%%)", total, formatNumber(int64(d.Summary.PreviousWeekTotal)), ratio)
+}
+
+// rowsChanged は削除・追加した件数と差分
+func (d Digest) rowsChanged() string {
+	diff := int64(d.Run.RowsInserted) - int64(d.Run.RowsDeleted)
+	sign := "+"
+	if diff < 0 {
+		sign = "-"
+		diff = -diff
+	}
+	return fmt.Sprintf("追加 %s / 削除 %s(差分 %s%s)", formatNumber(int64(d.Run.RowsInserted)), formatNumber(int64(d.Run.RowsDeleted)), sign, formatNumber(diff))
+}
+
+// formatNumber は3桁区切りにする
+func formatNumber(n int64) string {
+	s := strconv.FormatInt(n, 10)
+	sign := ""
+	if strings.HasPrefix(s, "-") {
+		sign, s = "-", s[1:]
+	}
+	for i := len(s) - 3; i > 0; i -= 3 {
+		s = s[:i] + "," + s[i:]
+	}
+	return sign + s
+}
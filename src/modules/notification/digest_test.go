@@ -0,0 +1,53 @@
+package notification
+
+import (
+	"corona-api/src/modules/date"
+	"corona-api/src/modules/ingestion"
+	"corona-api/src/modules/patient"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestDigest_Message(t *testing.T) {
+	d := Digest{
+		Run: ingestion.Run{ID: 12, RowsRead: 48000, RowsDeleted: 47953, RowsInserted: 48000},
+		Summary: patient.Digest{
+			LatestDate:        date.MustParse("20230114"),
+			TopAreas:          []patient.AreaValue{{Area: "東京都", Value: 12345}, {Area: "大阪府", Value: 6789}},
+			WeekTotal:         110000,
+			PreviousWeekTotal: 100000,
+		},
+	}
+	m := d.Message()
+
+	assert.Equal(t, EventPatientDetailsDigest, m.Event)
+	assert.Equal(t, LevelInfo, m.Level)
+	assert.Equal(t, []Field{
+		{"最新日付", "2023-01-14"},
+		{"全国(直近7日間)", "110,000人(前週 100,000人、前週比 +10.0%)"},
+		{"取り込み件数", "48,000"},
+		{"変更件数", "追加 48,000 / 削除 47,953(差分 +47)"},
+		{"取り込み履歴ID", "12"},
+	}, m.Fields)
+	assert.Equal(t, "感染者数の多い都道府県(2023-01-14)\n1. 東京都 12,345人\n2. 大阪府 6,789人", m.Detail)
+}
+
+func TestFormatNumber(t *testing.T) {
+	tests := []struct {
+		n    int64
+		want string
+	}{
+		{0, "0"},
+		{999, "999"},
+		{1000, "1,000"},
+		{1234567, "1,234,567"},
+		{-1234, "-1,234"},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.want, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.want, formatNumber(tt.n))
+		})
+	}
+}
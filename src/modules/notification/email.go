@@ -0,0 +1,80 @@
+package notification
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"fmt"
+	"mime"
+	"net"
+	"net/smtp"
+	"strings"
+	"time"
+)
+
+const (
+	// base64 の1行の最大文字数(RFC 2045)
+	emailLineLength = 76
+)
+
+// EmailNotifier は SMTP でプレーンテキストのメールを送る
+// Username が空の場合は認証しない
+type EmailNotifier struct {
+	Addr     string
+	Username string
+	Password string
+	From     string
+	To       []string
+	Now      func() time.Time
+}
+
+func (n *EmailNotifier) Notify(ctx context.Context, m Message) error {
+	host, _, err := net.SplitHostPort(n.Addr)
+	if err != nil {
+		return fmt.Errorf("net.SplitHostPort() error: %v", err)
+	}
+	var auth smtp.Auth
+	if n.Username != "" {
+		auth = smtp.PlainAuth("", n.Username, n.Password, host)
+	}
+
+	now := time.Now
+	if n.Now != nil {
+		now = n.Now
+	}
+
+	// smtp.SendMail は context に対応していないので別 goroutine で送ってキャンセルを待つ
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- smtp.SendMail(n.Addr, auth, n.From, n.To, n.build(m, now()))
+	}()
+	select {
+	case err := <-errCh:
+		if err != nil {
+			return fmt.Errorf("smtp.SendMail() error: %v", err)
+		}
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (n *EmailNotifier) build(m Message, date time.Time) []byte {
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "From: %s\r\n", n.From)
+	fmt.Fprintf(&b, "To: %s\r\n", strings.Join(n.To, ", "))
+	fmt.Fprintf(&b, "Subject: %s\r\n", mime.BEncoding.Encode("UTF-8", m.Title))
+	fmt.Fprintf(&b, "Date: %s\r\n", date.Format(time.RFC1123Z))
+	b.WriteString("MIME-Version: 1.0\r\n")
+	b.WriteString("Content-Type: text/plain; charset=UTF-8\r\n")
+	b.WriteString("Content-Transfer-Encoding: base64\r\n")
+	b.WriteString("\r\n")
+
+	encoded := base64.StdEncoding.EncodeToString([]byte(m.PlainText()))
+	for len(encoded) > emailLineLength {
+		b.WriteString(encoded[:emailLineLength] + "\r\n")
+		encoded = encoded[emailLineLength:]
+	}
+	b.WriteString(encoded + "\r\n")
+	return b.Bytes()
+}
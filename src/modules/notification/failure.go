@@ -0,0 +1,72 @@
+package notification
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+const (
+	DefaultFailureText = "定期実行に失敗しました"
+)
+
+// Failure は定期実行(Step Functions)の失敗内容
+type Failure struct {
+	FailedState string
+	Error       string
+	Cause       string
+	ObjectKey   string
+	ExecutionID string
+	RunID       int64
+	Region      string
+	Bucket      string
+}
+
+const (
+	EventWorkflowFailed = "workflow.failed"
+)
+
+// Message は失敗内容の通知
+func (f Failure) Message() Message {
+	return Message{
+		Event: EventWorkflowFailed,
+		Level: LevelAlert,
+		Title: DefaultFailureText,
+		Fields: nonEmptyFields(
+			Field{"ステート", f.FailedState},
+			Field{"エラー", f.Error},
+			Field{"オブジェクトキー", f.ObjectKey},
+			Field{"実行ID", f.ExecutionID},
+			Field{"取り込み履歴ID", runID(f.RunID)},
+		),
+		Detail: f.Cause,
+		Links:  f.Links(),
+	}
+}
+
+// Text はプレーンテキストの通知本文
+func (f Failure) Text() string {
+	return f.Message().PlainText()
+}
+
+// Links はAWSコンソールへのリンク
+func (f Failure) Links() []Link {
+	if f.Region == "" {
+		return nil
+	}
+	var links []Link
+	// 実行IDは実行のARN(ローカル実行の場合はARNではない)
+	if strings.HasPrefix(f.ExecutionID, "arn:") {
+		links = append(links, Link{
+			Text: "実行履歴",
+			URL:  fmt.Sprintf("https://%s.console.aws.amazon.com/states/home?region=%s#/v2/executions/details/%s", f.Region, f.Region, url.PathEscape(f.ExecutionID)),
+		})
+	}
+	if f.ObjectKey != "" && f.Bucket != "" {
+		links = append(links, Link{
+			Text: "S3オブジェクト",
+			URL:  fmt.Sprintf("https://s3.console.aws.amazon.com/s3/object/%s?region=%s&prefix=%s", f.Bucket, f.Region, url.QueryEscape(f.ObjectKey)),
+		})
+	}
+	return links
+}
@@ -0,0 +1,130 @@
+package notification
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"syscall"
+)
+
+var (
+	// ErrInvalidWebhookURL は https の絶対URLではない場合のエラー
+	ErrInvalidWebhookURL = errors.New("invalid webhook url")
+	// ErrForbiddenAddress は内部のネットワークのアドレスへ送ろうとした場合のエラー
+	ErrForbiddenAddress = errors.New("forbidden address")
+)
+
+// forbiddenNetworks は利用者が登録した宛先に送らないアドレスの範囲
+// (未指定、ループバック、プライベート、共有、リンクローカル(メタデータの 169.254.169.254 を含む)、
+// ベンチマーク、マルチキャスト、予約済み、ユニークローカル(メタデータの fd00:ec2::254 を含む)、NAT64)
+var forbiddenNetworks = mustParseCIDRs(
+	"0.0.0.0/8",
+	"10.0.0.0/8",
+	"100.64.0.0/10",
+	"127.0.0.0/8",
+	"169.254.0.0/16",
+	"172.16.0.0/12",
+	"192.0.0.0/24",
+	"192.168.0.0/16",
+	"198.18.0.0/15",
+	"224.0.0.0/4",
+	"240.0.0.0/4",
+	"::/128",
+	"::1/128",
+	"64:ff9b::/96",
+	"fc00::/7",
+	"fe80::/10",
+	"ff00::/8",
+)
+
+func mustParseCIDRs(cidrs ...string) []*net.IPNet {
+	networks := make([]*net.IPNet, len(cidrs))
+	for i, cidr := range cidrs {
+		_, network, err := net.ParseCIDR(cidr)
+		if err != nil {
+			panic(err)
+		}
+		networks[i] = network
+	}
+	return networks
+}
+
+// lookupIPAddr はホスト名を解決する(テストで差し替える)
+var lookupIPAddr = net.DefaultResolver.LookupIPAddr
+
+// IsPublicIP は利用者が登録した宛先として送ってよいアドレスか判定する
+func IsPublicIP(ip net.IP) bool {
+	for _, network := range forbiddenNetworks {
+		if network.Contains(ip) {
+			return false
+		}
+	}
+	return true
+}
+
+// ValidateWebhookURL は利用者が登録する Webhook のURLが https で、
+// ホストの全てのアドレスが内部のネットワークではないか検証する
+// 登録後に DNS の応答が変わる場合に備えて、送信時も NewWebhookClient で接続先を検証する
+func ValidateWebhookURL(ctx context.Context, rawURL string) error {
+	u, err := url.Parse(rawURL)
+	if err != nil || u.Scheme != "https" || u.Hostname() == "" || u.User != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidWebhookURL, rawURL)
+	}
+	host := u.Hostname()
+	if ip := net.ParseIP(host); ip != nil {
+		if !IsPublicIP(ip) {
+			return fmt.Errorf("%w: %v", ErrForbiddenAddress, ip)
+		}
+		return nil
+	}
+	addrs, err := lookupIPAddr(ctx, host)
+	if err != nil {
+		return fmt.Errorf("%w: lookup error: %v", ErrInvalidWebhookURL, err)
+	}
+	for _, addr := range addrs {
+		if !IsPublicIP(addr.IP) {
+			return fmt.Errorf("%w: %v: %v", ErrForbiddenAddress, host, addr.IP)
+		}
+	}
+	return nil
+}
+
+// NewWebhookClient は利用者が登録した Webhook へ送る HTTP クライアント
+// 名前解決した後の接続先を検証するので、DNS の応答が変わっても内部のネットワークへは接続しない
+// プロキシ経由では接続先を検証できないため、環境変数のプロキシは使わない
+func NewWebhookClient() *http.Client {
+	dialer := &net.Dialer{Timeout: DefaultHTTPTimeout, Control: controlPublicAddress}
+	return &http.Client{
+		Timeout: DefaultHTTPTimeout,
+		Transport: &http.Transport{
+			DialContext:         dialer.DialContext,
+			TLSHandshakeTimeout: DefaultHTTPTimeout,
+		},
+		// リダイレクト先も https に限る
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			if req.URL.Scheme != "https" {
+				return fmt.Errorf("%w: redirect to %v", ErrInvalidWebhookURL, req.URL)
+			}
+			if len(via) >= 10 {
+				return errors.New("stopped after 10 redirects")
+			}
+			return nil
+		},
+	}
+}
+
+// controlPublicAddress は接続する直前に接続先のアドレスを検証する
+func controlPublicAddress(network string, address string, c syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return fmt.Errorf("net.SplitHostPort() error: %v", err)
+	}
+	ip := net.ParseIP(host)
+	if ip == nil || !IsPublicIP(ip) {
+		return fmt.Errorf("%w: %v", ErrForbiddenAddress, address)
+	}
+	return nil
+}
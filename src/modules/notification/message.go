@@ -0,0 +1,60 @@
+package notification
+
+import (
+	"fmt"
+	"strings"
+)
+
+// 通知の重要度
+const (
+	LevelInfo  = "info"
+	LevelAlert = "alert"
+)
+
+// Message は通知先に依存しない通知内容
+// 各通知先の形式(Slack の Block Kit、Teams の Adaptive Card など)へはそれぞれの Notifier が変換する
+type Message struct {
+	Event  string
+	Level  string
+	Title  string
+	Fields []Field
+	// Detail はエラーの原因などの整形済みテキスト
+	Detail string
+	Links  []Link
+}
+
+type Field struct {
+	Name  string
+	Value string
+}
+
+type Link struct {
+	Text string
+	URL  string
+}
+
+// PlainText はプレーンテキストの通知本文
+func (m Message) PlainText() string {
+	lines := []string{m.Title}
+	for _, field := range m.Fields {
+		lines = append(lines, fmt.Sprintf("%s: %s", field.Name, field.Value))
+	}
+	if m.Detail != "" {
+		lines = append(lines, m.Detail)
+	}
+	for _, link := range m.Links {
+		lines = append(lines, fmt.Sprintf("%s: %s", link.Text, link.URL))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// nonEmptyFields は値が空のフィールドを除く
+func nonEmptyFields(fields ...Field) []Field {
+	var nonEmpty []Field
+	for _, field := range fields {
+		if field.Value != "" {
+			nonEmpty = append(nonEmpty, field)
+		}
+	}
+	return nonEmpty
+}
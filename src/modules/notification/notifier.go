@@ -0,0 +1,132 @@
+package notification
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const (
+	ChannelTypeSlack   = "slack"
+	ChannelTypeTeams   = "teams"
+	ChannelTypeWebhook = "webhook"
+	ChannelTypeEmail   = "email"
+)
+
+const (
+	DefaultHTTPTimeout = 10 * time.Second
+)
+
+// Notifier は通知先へメッセージを送る
+type Notifier interface {
+	Notify(ctx context.Context, m Message) error
+}
+
+// Channel は通知先の設定
+type Channel struct {
+	Type string `json:"type"`
+	// slack, teams, webhook
+	URL string `json:"url,omitempty"`
+	// webhook の署名鍵
+	Secret string `json:"secret,omitempty"`
+	// email
+	SMTPAddr string   `json:"smtp_addr,omitempty"`
+	Username string   `json:"username,omitempty"`
+	Password string   `json:"password,omitempty"`
+	From     string   `json:"from,omitempty"`
+	To       []string `json:"to,omitempty"`
+}
+
+// NewNotifier は通知先の設定から Notifier を作る
+func NewNotifier(channel Channel) (Notifier, error) {
+	switch channel.Type {
+	case ChannelTypeSlack:
+		if channel.URL == "" {
+			return nil, fmt.Errorf("slack channel: url is required")
+		}
+		return &SlackNotifier{WebhookURL: channel.URL}, nil
+	case ChannelTypeTeams:
+		if channel.URL == "" {
+			return nil, fmt.Errorf("teams channel: url is required")
+		}
+		return &TeamsNotifier{WebhookURL: channel.URL}, nil
+	case ChannelTypeWebhook:
+		if channel.URL == "" {
+			return nil, fmt.Errorf("webhook channel: url is required")
+		}
+		return &WebhookNotifier{URL: channel.URL, Secret: channel.Secret}, nil
+	case ChannelTypeEmail:
+		if channel.SMTPAddr == "" || channel.From == "" || len(channel.To) == 0 {
+			return nil, fmt.Errorf("email channel: smtp_addr, from and to are required")
+		}
+		return &EmailNotifier{
+			Addr:     channel.SMTPAddr,
+			Username: channel.Username,
+			Password: channel.Password,
+			From:     channel.From,
+			To:       channel.To,
+		}, nil
+	default:
+		return nil, fmt.Errorf("unsupported channel type: %q", channel.Type)
+	}
+}
+
+// MultiNotifier は複数の通知先へ同じメッセージを送る
+// 一部の通知先で失敗しても残りの通知先へは送る
+type MultiNotifier []Notifier
+
+func NewMultiNotifier(channels []Channel) (MultiNotifier, error) {
+	var notifiers MultiNotifier
+	for i, channel := range channels {
+		n, err := NewNotifier(channel)
+		if err != nil {
+			return nil, fmt.Errorf("channels[%d]: %v", i, err)
+		}
+		notifiers = append(notifiers, n)
+	}
+	return notifiers, nil
+}
+
+func (mn MultiNotifier) Notify(ctx context.Context, m Message) error {
+	var messages []string
+	for i, n := range mn {
+		if err := n.Notify(ctx, m); err != nil {
+			messages = append(messages, fmt.Sprintf("channels[%d]: %v", i, err))
+		}
+	}
+	if len(messages) > 0 {
+		return fmt.Errorf("notify error: %d of %d channels failed: %s", len(messages), len(mn), strings.Join(messages, "; "))
+	}
+	return nil
+}
+
+// postJSON は JSON を POST して 2xx 以外をエラーにする
+func postJSON(ctx context.Context, client *http.Client, url string, body []byte, header http.Header) error {
+	if client == nil {
+		client = &http.Client{Timeout: DefaultHTTPTimeout}
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("http.NewRequest() error: %v", err)
+	}
+	for key, values := range header {
+		req.Header[key] = values
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("client.Do() error: %v", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		// レスポンス本文にエラー内容が入っていることがあるので先頭だけ含める
+		b, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return fmt.Errorf("webhook error: status: %v, body: %s", resp.StatusCode, b)
+	}
+	return nil
+}
@@ -0,0 +1,257 @@
+package notification
+
+import (
+	"bufio"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+var testMessage = Message{
+	Event:  EventWorkflowFailed,
+	Level:  LevelAlert,
+	Title:  DefaultFailureText,
+	Fields: []Field{{"ステート", "Update patient details table"}},
+	Detail: "db error",
+	Links:  []Link{{"実行履歴", "https://example.com/executions/1"}},
+}
+
+type receivedRequest struct {
+	header http.Header
+	body   []byte
+}
+
+// newWebhookServer は受け取ったリクエストを記録するローカルの Webhook
+func newWebhookServer(t *testing.T, status int) (*httptest.Server, *[]receivedRequest) {
+	var received []receivedRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		assert.NoError(t, err)
+		received = append(received, receivedRequest{r.Header, body})
+		w.WriteHeader(status)
+	}))
+	t.Cleanup(server.Close)
+	return server, &received
+}
+
+func TestSlackNotifier(t *testing.T) {
+	server, received := newWebhookServer(t, http.StatusOK)
+
+	err := (&SlackNotifier{WebhookURL: server.URL}).Notify(context.Background(), testMessage)
+	assert.NoError(t, err)
+	assert.Len(t, *received, 1)
+	assert.Equal(t, "application/json", (*received)[0].header.Get("Content-Type"))
+
+	var message SlackMessage
+	assert.NoError(t, json.Unmarshal((*received)[0].body, &message))
+	assert.Equal(t, []string{"header", "section", "section", "actions"}, blockTypes(message))
+	assert.Equal(t, ":rotating_light: "+DefaultFailureText, message.Blocks[0].Text.Text)
+}
+
+func TestTeamsNotifier(t *testing.T) {
+	server, received := newWebhookServer(t, http.StatusAccepted)
+
+	err := (&TeamsNotifier{WebhookURL: server.URL}).Notify(context.Background(), testMessage)
+	assert.NoError(t, err)
+	assert.Len(t, *received, 1)
+
+	var message TeamsMessage
+	assert.NoError(t, json.Unmarshal((*received)[0].body, &message))
+	assert.Equal(t, TeamsAdaptiveCardContentType, message.Attachments[0].ContentType)
+	card := message.Attachments[0].Content
+	assert.Equal(t, "Attention", card.Body[0].Color)
+	assert.Equal(t, []TeamsFact{{"ステート", "Update patient details table"}}, card.Body[1].Facts)
+	assert.Equal(t, "https://example.com/executions/1", card.Actions[0].URL)
+}
+
+func TestWebhookNotifier(t *testing.T) {
+	server, received := newWebhookServer(t, http.StatusNoContent)
+	sentAt := time.Date(2023, 1, 1, 22, 18, 19, 0, time.UTC)
+
+	n := &WebhookNotifier{URL: server.URL, Secret: "secret", Now: func() time.Time { return sentAt }}
+	assert.NoError(t, n.Notify(context.Background(), testMessage))
+	assert.Len(t, *received, 1)
+
+	req := (*received)[0]
+	timestamp, err := strconv.ParseInt(req.header.Get(WebhookTimestampHeader), 10, 64)
+	assert.NoError(t, err)
+	assert.Equal(t, sentAt.Unix(), timestamp)
+	assert.True(t, VerifySignature("secret", timestamp, req.body, req.header.Get(WebhookSignatureHeader)))
+	assert.False(t, VerifySignature("other", timestamp, req.body, req.header.Get(WebhookSignatureHeader)))
+
+	var payload WebhookPayload
+	assert.NoError(t, json.Unmarshal(req.body, &payload))
+	assert.Equal(t, NewWebhookPayload(testMessage, sentAt), payload)
+}
+
+func TestWebhookNotifier_Unsigned(t *testing.T) {
+	server, received := newWebhookServer(t, http.StatusOK)
+
+	assert.NoError(t, (&WebhookNotifier{URL: server.URL}).Notify(context.Background(), testMessage))
+	assert.Empty(t, (*received)[0].header.Get(WebhookSignatureHeader))
+}
+
+func TestRetryable(t *testing.T) {
+	assert.True(t, Retryable(0))
+	assert.True(t, Retryable(http.StatusTooManyRequests))
+	assert.True(t, Retryable(http.StatusBadGateway))
+	assert.False(t, Retryable(http.StatusNotFound))
+}
+
+func TestMultiNotifier(t *testing.T) {
+	ok, okReceived := newWebhookServer(t, http.StatusOK)
+	ng, ngReceived := newWebhookServer(t, http.StatusInternalServerError)
+
+	notifier, err := NewMultiNotifier([]Channel{
+		{Type: ChannelTypeSlack, URL: ng.URL},
+		{Type: ChannelTypeTeams, URL: ok.URL},
+		{Type: ChannelTypeWebhook, URL: ok.URL, Secret: "secret"},
+	})
+	assert.NoError(t, err)
+
+	// 失敗した通知先があっても残りの通知先へ送る
+	err = notifier.Notify(context.Background(), testMessage)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "1 of 3 channels failed")
+	assert.Contains(t, err.Error(), "channels[0]")
+	assert.Len(t, *ngReceived, 1)
+	assert.Len(t, *okReceived, 2)
+}
+
+func TestNewMultiNotifier_InvalidChannel(t *testing.T) {
+	tests := []struct {
+		name    string
+		channel Channel
+	}{
+		{"unsupported type", Channel{Type: "pager"}},
+		{"slack without url", Channel{Type: ChannelTypeSlack}},
+		{"email without to", Channel{Type: ChannelTypeEmail, SMTPAddr: "127.0.0.1:25", From: "a@example.com"}},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			_, err := NewMultiNotifier([]Channel{tt.channel})
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestParseChannels(t *testing.T) {
+	channels, err := ParseChannels([]byte(`[
+		{"type":"slack","url":"https://hooks.slack.com/services/x"},
+		{"type":"email","smtp_addr":"smtp.example.com:587","from":"noreply@example.com","to":["ops@example.com"]}
+	]`))
+	assert.NoError(t, err)
+	assert.Equal(t, []Channel{
+		{Type: ChannelTypeSlack, URL: "https://hooks.slack.com/services/x"},
+		{Type: ChannelTypeEmail, SMTPAddr: "smtp.example.com:587", From: "noreply@example.com", To: []string{"ops@example.com"}},
+	}, channels)
+
+	channels, err = ParseChannels(nil)
+	assert.NoError(t, err)
+	assert.Empty(t, channels)
+}
+
+// smtpServer は最低限のコマンドだけ受け付けるローカルの SMTP サーバー
+type smtpServer struct {
+	addr       string
+	recipients []string
+	data       string
+	done       chan struct{}
+}
+
+func newSMTPServer(t *testing.T) *smtpServer {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.NoError(t, err)
+	t.Cleanup(func() { listener.Close() })
+
+	s := &smtpServer{addr: listener.Addr().String(), done: make(chan struct{})}
+	go func() {
+		defer close(s.done)
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		s.serve(conn)
+	}()
+	return s
+}
+
+func (s *smtpServer) serve(conn net.Conn) {
+	r := bufio.NewReader(conn)
+	reply := func(line string) { io.WriteString(conn, line+"\r\n") }
+	reply("220 localhost ESMTP")
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			return
+		}
+		command := strings.ToUpper(strings.TrimSpace(line))
+		switch {
+		case strings.HasPrefix(command, "EHLO"), strings.HasPrefix(command, "HELO"):
+			reply("250 localhost")
+		case strings.HasPrefix(command, "RCPT TO:"):
+			s.recipients = append(s.recipients, strings.Trim(strings.TrimSpace(line)[len("RCPT TO:"):], "<>"))
+			reply("250 OK")
+		case command == "DATA":
+			reply("354 End data with <CR><LF>.<CR><LF>")
+			var data strings.Builder
+			for {
+				l, err := r.ReadString('\n')
+				if err != nil || l == ".\r\n" {
+					break
+				}
+				data.WriteString(l)
+			}
+			s.data = data.String()
+			reply("250 OK")
+		case command == "QUIT":
+			reply("221 Bye")
+			return
+		default:
+			reply("250 OK")
+		}
+	}
+}
+
+func TestEmailNotifier(t *testing.T) {
+	server := newSMTPServer(t)
+
+	n := &EmailNotifier{
+		Addr: server.addr,
+		From: "noreply@example.com",
+		To:   []string{"ops@example.com", "dev@example.com"},
+		Now:  func() time.Time { return time.Date(2023, 1, 1, 22, 18, 19, 0, time.UTC) },
+	}
+	assert.NoError(t, n.Notify(context.Background(), testMessage))
+	<-server.done
+
+	assert.Equal(t, []string{"ops@example.com", "dev@example.com"}, server.recipients)
+	header, body := splitMail(server.data)
+	assert.Contains(t, header, "Subject: =?UTF-8?b?")
+	assert.Contains(t, header, "To: ops@example.com, dev@example.com")
+	assert.Contains(t, header, "Date: Sun, 01 Jan 2023 22:18:19 +0000")
+
+	decoded, err := base64.StdEncoding.DecodeString(strings.ReplaceAll(body, "\r\n", ""))
+	assert.NoError(t, err)
+	assert.Equal(t, testMessage.PlainText(), string(decoded))
+}
+
+func splitMail(data string) (string, string) {
+	parts := strings.SplitN(data, "\r\n\r\n", 2)
+	if len(parts) != 2 {
+		return data, ""
+	}
+	return parts[0], parts[1]
+}
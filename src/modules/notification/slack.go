@@ -0,0 +1,112 @@
+package notification
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"unicode/utf8"
+)
+
+const (
+	// Block Kit のテキストの最大文字数
+	SlackTextMaxLength = 3000
+)
+
+// SlackMessage はSlackのBlock Kitメッセージ
+// text はBlock Kitを表示できない通知などで使われるプレーンテキスト
+type SlackMessage struct {
+	Text   string       `json:"text"`
+	Blocks []SlackBlock `json:"blocks,omitempty"`
+}
+
+type SlackBlock struct {
+	Type     string         `json:"type"`
+	Text     *SlackText     `json:"text,omitempty"`
+	Fields   []SlackText    `json:"fields,omitempty"`
+	Elements []SlackElement `json:"elements,omitempty"`
+}
+
+type SlackText struct {
+	Type string `json:"type"`
+	Text string `json:"text"`
+}
+
+type SlackElement struct {
+	Type string     `json:"type"`
+	Text *SlackText `json:"text,omitempty"`
+	URL  string     `json:"url,omitempty"`
+}
+
+func NewSlackFailureMessage(f Failure) SlackMessage {
+	return NewSlackMessage(f.Message())
+}
+
+func NewSlackMessage(m Message) SlackMessage {
+	blocks := []SlackBlock{
+		{Type: "header", Text: &SlackText{Type: "plain_text", Text: slackLevelIcon(m.Level) + m.Title}},
+	}
+
+	if len(m.Fields) > 0 {
+		var fields []SlackText
+		for _, field := range m.Fields {
+			fields = append(fields, SlackText{Type: "mrkdwn", Text: fmt.Sprintf("*%s*\n%s", field.Name, field.Value)})
+		}
+		blocks = append(blocks, SlackBlock{Type: "section", Fields: fields})
+	}
+
+	if m.Detail != "" {
+		blocks = append(blocks, SlackBlock{Type: "section", Text: &SlackText{Type: "mrkdwn", Text: truncateCodeBlock(fmt.Sprintf("```%s```", m.Detail), SlackTextMaxLength)}})
+	}
+
+	if len(m.Links) > 0 {
+		var elements []SlackElement
+		for _, link := range m.Links {
+			elements = append(elements, SlackElement{Type: "button", Text: &SlackText{Type: "plain_text", Text: link.Text}, URL: link.URL})
+		}
+		blocks = append(blocks, SlackBlock{Type: "actions", Elements: elements})
+	}
+
+	return SlackMessage{
+		Text:   m.PlainText(),
+		Blocks: blocks,
+	}
+}
+
+func slackLevelIcon(level string) string {
+	if level == LevelAlert {
+		return ":rotating_light: "
+	}
+	return ""
+}
+
+// SlackNotifier は Incoming Webhook へ JSON で通知する
+type SlackNotifier struct {
+	WebhookURL string
+	Client     *http.Client
+}
+
+func (n *SlackNotifier) Notify(ctx context.Context, m Message) error {
+	body, err := json.Marshal(NewSlackMessage(m))
+	if err != nil {
+		return fmt.Errorf("json.Marshal() error: %v", err)
+	}
+	return postJSON(ctx, n.Client, n.WebhookURL, body, nil)
+}
+
+func runID(id int64) string {
+	if id == 0 {
+		return ""
+	}
+	return strconv.FormatInt(id, 10)
+}
+
+// truncateCodeBlock はコードブロックを閉じたまま最大文字数に収める
+func truncateCodeBlock(s string, maxLength int) string {
+	if utf8.RuneCountInString(s) <= maxLength {
+		return s
+	}
+	runes := []rune(s)
+	return string(runes[:maxLength-4]) + "…```"
+}
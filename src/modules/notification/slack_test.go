@@ -0,0 +1,47 @@
+package notification
+
+import (
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+)
+
+func TestNewSlackFailureMessage(t *testing.T) {
+	f := Failure{
+		FailedState: "Update patient details table",
+		Error:       "States.TaskFailed",
+		Cause:       "db error",
+		ObjectKey:   "20230101221819",
+		ExecutionID: "arn:aws:states:ap-northeast-1:000000000000:execution:UpdatePatientDetails:abc",
+		Region:      "ap-northeast-1",
+		Bucket:      "patient-details-file-dev",
+	}
+	message := NewSlackFailureMessage(f)
+
+	assert.True(t, strings.HasPrefix(message.Text, DefaultFailureText))
+	assert.Contains(t, message.Text, "db error")
+	assert.Equal(t, []string{"header", "section", "section", "actions"}, blockTypes(message))
+	assert.Len(t, message.Blocks[1].Fields, 4)
+	assert.Len(t, message.Blocks[3].Elements, 2)
+	assert.Contains(t, message.Blocks[3].Elements[1].URL, "prefix=20230101221819")
+}
+
+func TestNewSlackFailureMessage_Empty(t *testing.T) {
+	message := NewSlackFailureMessage(Failure{})
+
+	assert.Equal(t, DefaultFailureText, message.Text)
+	assert.Equal(t, []string{"header"}, blockTypes(message))
+}
+
+func TestTruncateCodeBlock(t *testing.T) {
+	got := truncateCodeBlock("```"+strings.Repeat("あ", 10)+"```", 10)
+	assert.Equal(t, "```あああ…```", got)
+}
+
+func blockTypes(message SlackMessage) []string {
+	var types []string
+	for _, block := range message.Blocks {
+		types = append(types, block.Type)
+	}
+	return types
+}
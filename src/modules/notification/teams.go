@@ -0,0 +1,107 @@
+package notification
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+const (
+	TeamsAdaptiveCardContentType = "application/vnd.microsoft.card.adaptive"
+	TeamsAdaptiveCardVersion     = "1.4"
+)
+
+// TeamsMessage は Teams の Incoming Webhook(ワークフロー)へ送る Adaptive Card のメッセージ
+type TeamsMessage struct {
+	Type        string            `json:"type"`
+	Attachments []TeamsAttachment `json:"attachments"`
+}
+
+type TeamsAttachment struct {
+	ContentType string            `json:"contentType"`
+	Content     TeamsAdaptiveCard `json:"content"`
+}
+
+type TeamsAdaptiveCard struct {
+	Schema  string        `json:"$schema"`
+	Type    string        `json:"type"`
+	Version string        `json:"version"`
+	Body    []TeamsItem   `json:"body"`
+	Actions []TeamsAction `json:"actions,omitempty"`
+}
+
+type TeamsItem struct {
+	Type     string      `json:"type"`
+	Text     string      `json:"text,omitempty"`
+	Size     string      `json:"size,omitempty"`
+	Weight   string      `json:"weight,omitempty"`
+	Color    string      `json:"color,omitempty"`
+	FontType string      `json:"fontType,omitempty"`
+	Wrap     bool        `json:"wrap,omitempty"`
+	Facts    []TeamsFact `json:"facts,omitempty"`
+}
+
+type TeamsFact struct {
+	Title string `json:"title"`
+	Value string `json:"value"`
+}
+
+type TeamsAction struct {
+	Type  string `json:"type"`
+	Title string `json:"title"`
+	URL   string `json:"url"`
+}
+
+func NewTeamsMessage(m Message) TeamsMessage {
+	title := TeamsItem{Type: "TextBlock", Text: m.Title, Size: "Large", Weight: "Bolder", Wrap: true}
+	if m.Level == LevelAlert {
+		title.Color = "Attention"
+	}
+	body := []TeamsItem{title}
+
+	if len(m.Fields) > 0 {
+		var facts []TeamsFact
+		for _, field := range m.Fields {
+			facts = append(facts, TeamsFact{Title: field.Name, Value: field.Value})
+		}
+		body = append(body, TeamsItem{Type: "FactSet", Facts: facts})
+	}
+
+	if m.Detail != "" {
+		body = append(body, TeamsItem{Type: "TextBlock", Text: m.Detail, FontType: "Monospace", Wrap: true})
+	}
+
+	var actions []TeamsAction
+	for _, link := range m.Links {
+		actions = append(actions, TeamsAction{Type: "Action.OpenUrl", Title: link.Text, URL: link.URL})
+	}
+
+	return TeamsMessage{
+		Type: "message",
+		Attachments: []TeamsAttachment{{
+			ContentType: TeamsAdaptiveCardContentType,
+			Content: TeamsAdaptiveCard{
+				Schema:  "http://adaptivecards.io/schemas/adaptive-card.json",
+				Type:    "AdaptiveCard",
+				Version: TeamsAdaptiveCardVersion,
+				Body:    body,
+				Actions: actions,
+			},
+		}},
+	}
+}
+
+// TeamsNotifier は Teams の Incoming Webhook へ Adaptive Card で通知する
+type TeamsNotifier struct {
+	WebhookURL string
+	Client     *http.Client
+}
+
+func (n *TeamsNotifier) Notify(ctx context.Context, m Message) error {
+	body, err := json.Marshal(NewTeamsMessage(m))
+	if err != nil {
+		return fmt.Errorf("json.Marshal() error: %v", err)
+	}
+	return postJSON(ctx, n.Client, n.WebhookURL, body, nil)
+}
@@ -0,0 +1,146 @@
+package notification
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// 署名付き Webhook のヘッダー
+// 受信側は "<タイムスタンプ>.<本文>" の HMAC-SHA256 を秘密鍵で計算して署名と比較する
+const (
+	WebhookTimestampHeader = "X-Corona-Timestamp"
+	WebhookSignatureHeader = "X-Corona-Signature"
+	WebhookSignaturePrefix = "sha256="
+	// 配信IDとイベントの種類(受信側での重複排除や振り分けに使う)
+	WebhookDeliveryHeader = "X-Corona-Delivery"
+	WebhookEventHeader    = "X-Corona-Event"
+)
+
+// WebhookPayload は汎用 Webhook の本文
+type WebhookPayload struct {
+	Event  string         `json:"event"`
+	Level  string         `json:"level"`
+	Title  string         `json:"title"`
+	Fields []WebhookField `json:"fields"`
+	Detail string         `json:"detail,omitempty"`
+	Links  []WebhookLink  `json:"links"`
+	SentAt time.Time      `json:"sent_at"`
+}
+
+type WebhookField struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+type WebhookLink struct {
+	Text string `json:"text"`
+	URL  string `json:"url"`
+}
+
+func NewWebhookPayload(m Message, sentAt time.Time) WebhookPayload {
+	payload := WebhookPayload{
+		Event:  m.Event,
+		Level:  m.Level,
+		Title:  m.Title,
+		Fields: []WebhookField{},
+		Detail: m.Detail,
+		Links:  []WebhookLink{},
+		SentAt: sentAt,
+	}
+	for _, field := range m.Fields {
+		payload.Fields = append(payload.Fields, WebhookField{Name: field.Name, Value: field.Value})
+	}
+	for _, link := range m.Links {
+		payload.Links = append(payload.Links, WebhookLink{Text: link.Text, URL: link.URL})
+	}
+	return payload
+}
+
+// Sign は本文の署名("sha256=<hex>")を返す
+func Sign(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return WebhookSignaturePrefix + hex.EncodeToString(mac.Sum(nil))
+}
+
+// VerifySignature は受信側での署名の検証
+func VerifySignature(secret string, timestamp int64, body []byte, signature string) bool {
+	return hmac.Equal([]byte(Sign(secret, timestamp, body)), []byte(signature))
+}
+
+// WebhookNotifier は汎用の JSON Webhook へ通知する
+// Secret が空の場合は署名しない
+type WebhookNotifier struct {
+	URL    string
+	Secret string
+	Client *http.Client
+	Now    func() time.Time
+}
+
+func (n *WebhookNotifier) Notify(ctx context.Context, m Message) error {
+	now := time.Now
+	if n.Now != nil {
+		now = n.Now
+	}
+	sentAt := now()
+
+	body, err := json.Marshal(NewWebhookPayload(m, sentAt))
+	if err != nil {
+		return fmt.Errorf("json.Marshal() error: %v", err)
+	}
+
+	header := http.Header{}
+	if n.Secret != "" {
+		timestamp := sentAt.Unix()
+		header.Set(WebhookTimestampHeader, strconv.FormatInt(timestamp, 10))
+		header.Set(WebhookSignatureHeader, Sign(n.Secret, timestamp, body))
+	}
+	return postJSON(ctx, n.Client, n.URL, body, header)
+}
+
+// PostSigned は署名付きで JSON を POST し、レスポンスのステータスを返す
+// 通信エラーの場合のステータスは0
+func PostSigned(ctx context.Context, client *http.Client, url string, secret string, sentAt time.Time, header http.Header, body []byte) (int, error) {
+	if client == nil {
+		client = &http.Client{Timeout: DefaultHTTPTimeout}
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return 0, fmt.Errorf("http.NewRequest() error: %v", err)
+	}
+	for key, values := range header {
+		req.Header[key] = values
+	}
+	timestamp := sentAt.Unix()
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(WebhookTimestampHeader, strconv.FormatInt(timestamp, 10))
+	req.Header.Set(WebhookSignatureHeader, Sign(secret, timestamp, body))
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("client.Do() error: %v", err)
+	}
+	defer resp.Body.Close()
+	io.Copy(io.Discard, io.LimitReader(resp.Body, 4096))
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return resp.StatusCode, fmt.Errorf("webhook error: status: %v", resp.StatusCode)
+	}
+	return resp.StatusCode, nil
+}
+
+// Retryable は再送するレスポンスのステータスかどうか(0は通信エラー)
+// 4xx は受信側の設定の問題なので 408, 429 以外は再送しない
+func Retryable(status int) bool {
+	return status == 0 || status == http.StatusRequestTimeout || status == http.StatusTooManyRequests || status >= 500
+}
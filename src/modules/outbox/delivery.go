@@ -0,0 +1,144 @@
+package outbox
+
+import (
+	"context"
+	"corona-api/src/middleware"
+	"corona-api/src/modules/delivery"
+	"corona-api/src/modules/notification"
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+const (
+	DeliveryStatusPending   = delivery.StatusPending
+	DeliveryStatusSucceeded = delivery.StatusSucceeded
+	// DeliveryStatusDead は再送の上限に達した配信(デッドレター)
+	DeliveryStatusDead = "dead"
+)
+
+// 再送の設定
+const (
+	DeliveryMaxAttempts = 8
+	DeliveryBaseBackoff = 30 * time.Second
+	DeliveryMaxBackoff  = 6 * time.Hour
+	// 配信中の配信を他の処理が取得しないようにする時間
+	DeliveryLease = 5 * time.Minute
+	// 一度に処理する件数
+	DeliveryBatchSize = 100
+)
+
+// deliveryPolicy は Webhook の配信の再送と記録先
+var deliveryPolicy = delivery.Policy{
+	Table:        "webhook_deliveries",
+	MaxAttempts:  DeliveryMaxAttempts,
+	BaseBackoff:  DeliveryBaseBackoff,
+	MaxBackoff:   DeliveryMaxBackoff,
+	Lease:        DeliveryLease,
+	FailedStatus: DeliveryStatusDead,
+}
+
+var ErrDeadLetterNotFound = errors.New("dead letter not found")
+
+// Backoff は attempts 回目の失敗後に次の配信まで待つ時間
+func Backoff(attempts uint32) time.Duration {
+	return deliveryPolicy.Backoff(attempts)
+}
+
+// DeadLetter は再送の上限に達した配信
+type DeadLetter struct {
+	ID             int64     `json:"id"`
+	EventID        int64     `json:"event_id"`
+	EventType      string    `json:"event_type"`
+	Payload        string    `json:"payload"`
+	EndpointID     int64     `json:"endpoint_id"`
+	Attempts       uint32    `json:"attempts"`
+	ResponseStatus int       `json:"response_status,omitempty"`
+	ErrorMessage   string    `json:"error_message,omitempty"`
+	CreatedAt      time.Time `json:"created_at"`
+}
+
+// GetDeadLetters はデッドレターを新しい順に取得する
+func GetDeadLetters(ctx context.Context, q middleware.Querier, limit int) ([]DeadLetter, error) {
+	rows, err := q.QueryContext(ctx, "SELECT d.id, d.event_id, e.event_type, e.payload, d.endpoint_id, d.attempts, d.response_status, d.error_message, d.created_at FROM webhook_deliveries d JOIN outbox_events e ON e.id = d.event_id WHERE d.status = ? ORDER BY d.id DESC LIMIT ?",
+		DeliveryStatusDead, limit)
+	if err != nil {
+		return nil, fmt.Errorf("db.Query() error: %v", err)
+	}
+	defer rows.Close()
+
+	deadLetters := []DeadLetter{}
+	for rows.Next() {
+		var d DeadLetter
+		var responseStatus sql.NullInt64
+		var errorMessage sql.NullString
+		if err := rows.Scan(&d.ID, &d.EventID, &d.EventType, &d.Payload, &d.EndpointID, &d.Attempts, &responseStatus, &errorMessage, &d.CreatedAt); err != nil {
+			return nil, fmt.Errorf("rows.Scan() error: %v", err)
+		}
+		d.ResponseStatus = int(responseStatus.Int64)
+		d.ErrorMessage = errorMessage.String
+		deadLetters = append(deadLetters, d)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows.Err() error: %v", err)
+	}
+	return deadLetters, nil
+}
+
+// Replay はデッドレターを再送の対象に戻す
+// 送り先の Webhook が削除されている場合は戻さない
+func Replay(ctx context.Context, q middleware.Querier, id int64) error {
+	now := time.Now().UTC().Truncate(time.Second)
+	res, err := q.ExecContext(ctx, "UPDATE webhook_deliveries SET status = ?, attempts = 0, next_attempt_at = ? WHERE id = ? AND status = ? AND endpoint_id IN (SELECT id FROM webhook_endpoints)",
+		DeliveryStatusPending, now, id, DeliveryStatusDead)
+	if err != nil {
+		return fmt.Errorf("update webhook_deliveries error: %v", err)
+	}
+	replayed, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("res.RowsAffected() error: %v", err)
+	}
+	if replayed == 0 {
+		return ErrDeadLetterNotFound
+	}
+	return nil
+}
+
+// Deliverer は期限が来た配信を送る
+type Deliverer struct {
+	Client *http.Client
+	Now    func() time.Time
+}
+
+func NewDeliverer() *Deliverer {
+	return &Deliverer{
+		Client: notification.NewWebhookClient(),
+		Now:    time.Now,
+	}
+}
+
+// DeliverDue は期限が来た配信を送り、成功した件数を返す
+func (d *Deliverer) DeliverDue(ctx context.Context, q middleware.Querier) (int, error) {
+	now := d.Now().UTC().Truncate(time.Second)
+	rows, err := q.QueryContext(ctx, "SELECT d.id, d.attempts, e.event_type, e.payload, w.url, w.secret FROM webhook_deliveries d JOIN outbox_events e ON e.id = d.event_id JOIN webhook_endpoints w ON w.id = d.endpoint_id WHERE d.status = ? AND d.next_attempt_at <= ? ORDER BY d.id LIMIT ?",
+		DeliveryStatusPending, now, DeliveryBatchSize)
+	if err != nil {
+		return 0, fmt.Errorf("db.Query() error: %v", err)
+	}
+	var dues []delivery.Due
+	for rows.Next() {
+		var due delivery.Due
+		if err := rows.Scan(&due.ID, &due.Attempts, &due.Event, &due.Payload, &due.URL, &due.Secret); err != nil {
+			rows.Close()
+			return 0, fmt.Errorf("rows.Scan() error: %v", err)
+		}
+		dues = append(dues, due)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return 0, fmt.Errorf("rows.Err() error: %v", err)
+	}
+	return delivery.Sender{Policy: deliveryPolicy, Client: d.Client, Now: d.Now}.Deliver(ctx, q, dues)
+}
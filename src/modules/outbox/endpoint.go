@@ -0,0 +1,79 @@
+package outbox
+
+import (
+	"context"
+	"corona-api/src/middleware"
+	"errors"
+	"fmt"
+	"time"
+)
+
+var ErrEndpointNotFound = errors.New("webhook endpoint not found")
+
+// Endpoint はイベントを配信する Webhook
+type Endpoint struct {
+	ID        int64     `json:"id"`
+	URL       string    `json:"url"`
+	Secret    string    `json:"-"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+func CreateEndpoint(ctx context.Context, q middleware.Querier, e *Endpoint) error {
+	e.CreatedAt = time.Now().UTC().Truncate(time.Second)
+	res, err := q.ExecContext(ctx, "INSERT INTO webhook_endpoints (url, secret, created_at) VALUES (?,?,?)", e.URL, e.Secret, e.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("insert webhook_endpoints error: %v", err)
+	}
+	e.ID, err = res.LastInsertId()
+	if err != nil {
+		return fmt.Errorf("res.LastInsertId() error: %v", err)
+	}
+	return nil
+}
+
+func GetEndpoints(ctx context.Context, q middleware.Querier) ([]Endpoint, error) {
+	rows, err := q.QueryContext(ctx, "SELECT id, url, secret, created_at FROM webhook_endpoints ORDER BY id")
+	if err != nil {
+		return nil, fmt.Errorf("db.Query() error: %v", err)
+	}
+	defer rows.Close()
+
+	endpoints := []Endpoint{}
+	for rows.Next() {
+		var e Endpoint
+		if err := rows.Scan(&e.ID, &e.URL, &e.Secret, &e.CreatedAt); err != nil {
+			return nil, fmt.Errorf("rows.Scan() error: %v", err)
+		}
+		endpoints = append(endpoints, e)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows.Err() error: %v", err)
+	}
+	return endpoints, nil
+}
+
+// DeleteEndpoint は Webhook を削除し、未配信の配信をデッドレターにする
+func DeleteEndpoint(ctx context.Context, txAdmin *middleware.TxAdmin, id int64) error {
+	return txAdmin.Transaction(ctx, func(ctx context.Context) error {
+		q := txAdmin.Querier(ctx)
+
+		res, err := q.ExecContext(ctx, "DELETE FROM webhook_endpoints WHERE id = ?", id)
+		if err != nil {
+			return fmt.Errorf("delete webhook_endpoints error: %v", err)
+		}
+		deleted, err := res.RowsAffected()
+		if err != nil {
+			return fmt.Errorf("res.RowsAffected() error: %v", err)
+		}
+		if deleted == 0 {
+			return ErrEndpointNotFound
+		}
+
+		_, err = q.ExecContext(ctx, "UPDATE webhook_deliveries SET status = ?, error_message = ?, next_attempt_at = NULL WHERE endpoint_id = ? AND status = ?",
+			DeliveryStatusDead, "endpoint deleted", id, DeliveryStatusPending)
+		if err != nil {
+			return fmt.Errorf("update webhook_deliveries error: %v", err)
+		}
+		return nil
+	})
+}
@@ -0,0 +1,129 @@
+package outbox
+
+import (
+	"context"
+	"corona-api/src/middleware"
+	"corona-api/src/modules/date"
+	"corona-api/src/modules/patient"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+const (
+	EventPatientDetailsUpdated = "patient_details.updated"
+)
+
+// PatientDetailsUpdated は patient_details の更新イベントの本文
+type PatientDetailsUpdated struct {
+	Event       string    `json:"event"`
+	RunID       int64     `json:"run_id"`
+	StartDate   date.Date `json:"start_date"`
+	EndDate     date.Date `json:"end_date"`
+	Areas       []string  `json:"areas"`
+	RowsChanged int       `json:"rows_changed"`
+	OccurredAt  time.Time `json:"occurred_at"`
+}
+
+func NewPatientDetailsUpdated(runID int64, changeSet patient.ChangeSet, occurredAt time.Time) PatientDetailsUpdated {
+	return PatientDetailsUpdated{
+		Event:       EventPatientDetailsUpdated,
+		RunID:       runID,
+		StartDate:   changeSet.StartDate,
+		EndDate:     changeSet.EndDate,
+		Areas:       changeSet.Areas,
+		RowsChanged: changeSet.Rows,
+		OccurredAt:  occurredAt.UTC().Truncate(time.Second),
+	}
+}
+
+// Event はアウトボックスに登録したイベント
+type Event struct {
+	ID           int64
+	Type         string
+	Payload      string
+	CreatedAt    time.Time
+	DispatchedAt *time.Time
+}
+
+// Enqueue はイベントをアウトボックスに登録する
+// データの更新と同じトランザクションの Querier を渡す
+func Enqueue(ctx context.Context, q middleware.Querier, eventType string, payload interface{}) (Event, error) {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return Event{}, fmt.Errorf("json.Marshal() error: %v", err)
+	}
+	e := Event{
+		Type:      eventType,
+		Payload:   string(body),
+		CreatedAt: time.Now().UTC().Truncate(time.Second),
+	}
+	res, err := q.ExecContext(ctx, "INSERT INTO outbox_events (event_type, payload, created_at) VALUES (?,?,?)", e.Type, e.Payload, e.CreatedAt)
+	if err != nil {
+		return Event{}, fmt.Errorf("insert outbox_events error: %v", err)
+	}
+	e.ID, err = res.LastInsertId()
+	if err != nil {
+		return Event{}, fmt.Errorf("res.LastInsertId() error: %v", err)
+	}
+	return e, nil
+}
+
+// Dispatch は未配信のイベントを登録されている全ての Webhook の配信にし、配信にしたイベントの件数を返す
+func Dispatch(ctx context.Context, txAdmin *middleware.TxAdmin) (int, error) {
+	dispatched := 0
+	err := txAdmin.Transaction(ctx, func(ctx context.Context) error {
+		q := txAdmin.Querier(ctx)
+
+		rows, err := q.QueryContext(ctx, "SELECT id FROM outbox_events WHERE dispatched_at IS NULL ORDER BY id LIMIT ?", DeliveryBatchSize)
+		if err != nil {
+			return fmt.Errorf("db.Query() error: %v", err)
+		}
+		var eventIDs []int64
+		for rows.Next() {
+			var id int64
+			if err := rows.Scan(&id); err != nil {
+				rows.Close()
+				return fmt.Errorf("rows.Scan() error: %v", err)
+			}
+			eventIDs = append(eventIDs, id)
+		}
+		rows.Close()
+		if err := rows.Err(); err != nil {
+			return fmt.Errorf("rows.Err() error: %v", err)
+		}
+		if len(eventIDs) == 0 {
+			return nil
+		}
+
+		endpoints, err := GetEndpoints(ctx, q)
+		if err != nil {
+			return err
+		}
+
+		now := time.Now().UTC().Truncate(time.Second)
+		for _, eventID := range eventIDs {
+			// 同時に実行された他の処理が配信にしたイベントは飛ばす
+			res, err := q.ExecContext(ctx, "UPDATE outbox_events SET dispatched_at = ? WHERE id = ? AND dispatched_at IS NULL", now, eventID)
+			if err != nil {
+				return fmt.Errorf("update outbox_events error: %v", err)
+			}
+			if claimed, err := res.RowsAffected(); err != nil || claimed == 0 {
+				continue
+			}
+			for _, endpoint := range endpoints {
+				_, err := q.ExecContext(ctx, "INSERT INTO webhook_deliveries (event_id, endpoint_id, status, next_attempt_at, created_at) VALUES (?,?,?,?,?)",
+					eventID, endpoint.ID, DeliveryStatusPending, now, now)
+				if err != nil {
+					return fmt.Errorf("insert webhook_deliveries error: %v", err)
+				}
+			}
+			dispatched++
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return dispatched, nil
+}
@@ -0,0 +1,313 @@
+//go:build cgo
+// +build cgo
+
+package outbox
+
+import (
+	"context"
+	"corona-api/src/middleware"
+	"corona-api/src/modules/migration"
+	"corona-api/src/modules/notification"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+)
+
+// openOutboxDB はマイグレーションを適用した SQLite を開く(接続の設定は middleware と同じ)
+func openOutboxDB(t *testing.T) *sql.DB {
+	path := filepath.Join(t.TempDir(), "corona.db")
+	db, err := sql.Open(middleware.DriverSQLite, fmt.Sprintf("file:%s?_busy_timeout=5000&_journal_mode=WAL&_txlock=immediate", path))
+	assert.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+	migrations, err := migration.Load(filepath.Join("..", "..", "..", migration.SQLiteDir))
+	assert.NoError(t, err)
+	_, err = migration.Apply(context.Background(), db, migrations)
+	assert.NoError(t, err)
+	return db
+}
+
+// webhookServer はパスごとに決めたステータスを返し、配信IDごとの受信回数を数える
+type webhookServer struct {
+	*httptest.Server
+	mu       sync.Mutex
+	received map[string]int
+}
+
+func newWebhookServer(t *testing.T) *webhookServer {
+	s := &webhookServer{received: map[string]int{}}
+	statuses := map[string]int{"/ok": http.StatusOK, "/fail": http.StatusInternalServerError, "/bad": http.StatusBadRequest}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		s.received[r.Header.Get(notification.WebhookDeliveryHeader)]++
+		s.mu.Unlock()
+		w.WriteHeader(statuses[r.URL.Path])
+	}))
+	t.Cleanup(s.Close)
+	return s
+}
+
+func (s *webhookServer) total() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	total := 0
+	for _, n := range s.received {
+		total += n
+	}
+	return total
+}
+
+// newTestDeliverer はローカルの Webhook へ送れるクライアントと、now を返す時計の Deliverer
+func newTestDeliverer(server *webhookServer, now *time.Time) *Deliverer {
+	return &Deliverer{Client: server.Client(), Now: func() time.Time { return *now }}
+}
+
+type deliveryState struct {
+	status         string
+	attempts       uint32
+	responseStatus sql.NullInt64
+	errorMessage   sql.NullString
+	nextAttemptAt  sql.NullTime
+}
+
+func getDeliveryState(t *testing.T, db *sql.DB, endpointID int64) deliveryState {
+	var s deliveryState
+	err := db.QueryRow("SELECT status, attempts, response_status, error_message, next_attempt_at FROM webhook_deliveries WHERE endpoint_id = ?", endpointID).
+		Scan(&s.status, &s.attempts, &s.responseStatus, &s.errorMessage, &s.nextAttemptAt)
+	assert.NoError(t, err)
+	return s
+}
+
+func getDeliveryID(t *testing.T, db *sql.DB, endpointID int64) int64 {
+	var id int64
+	assert.NoError(t, db.QueryRow("SELECT id FROM webhook_deliveries WHERE endpoint_id = ?", endpointID).Scan(&id))
+	return id
+}
+
+// setupDeliveries は /ok, /fail, /bad の Webhook を登録し、1件のイベントをそれぞれの配信にする
+func setupDeliveries(t *testing.T, db *sql.DB, server *webhookServer) (ok Endpoint, fail Endpoint, bad Endpoint) {
+	ctx := context.Background()
+	endpoints := []*Endpoint{&ok, &fail, &bad}
+	for i, path := range []string{"/ok", "/fail", "/bad"} {
+		*endpoints[i] = Endpoint{URL: server.URL + path, Secret: "secret"}
+		assert.NoError(t, CreateEndpoint(ctx, db, endpoints[i]))
+	}
+	_, err := Enqueue(ctx, db, EventPatientDetailsUpdated, PatientDetailsUpdated{Event: EventPatientDetailsUpdated, RunID: 1})
+	assert.NoError(t, err)
+	dispatched, err := Dispatch(ctx, middleware.NewTxAdmin(db))
+	assert.NoError(t, err)
+	assert.Equal(t, 1, dispatched)
+	return ok, fail, bad
+}
+
+func countRows(t *testing.T, db *sql.DB, query string, args ...interface{}) int {
+	var n int
+	assert.NoError(t, db.QueryRow(query, args...).Scan(&n))
+	return n
+}
+
+func TestDispatch_SQLite(t *testing.T) {
+	db := openOutboxDB(t)
+	ctx := context.Background()
+	for _, url := range []string{"https://example.com/a", "https://example.com/b"} {
+		assert.NoError(t, CreateEndpoint(ctx, db, &Endpoint{URL: url, Secret: "secret"}))
+	}
+	const events = 5
+	for i := 0; i < events; i++ {
+		_, err := Enqueue(ctx, db, EventPatientDetailsUpdated, PatientDetailsUpdated{Event: EventPatientDetailsUpdated, RunID: int64(i + 1)})
+		assert.NoError(t, err)
+	}
+
+	// 同時に実行しても各イベントは1回だけ配信にする
+	var wg sync.WaitGroup
+	results := make([]int, 4)
+	for i := range results {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			dispatched, err := Dispatch(ctx, middleware.NewTxAdmin(db))
+			assert.NoError(t, err)
+			results[i] = dispatched
+		}(i)
+	}
+	wg.Wait()
+	total := 0
+	for _, dispatched := range results {
+		total += dispatched
+	}
+	assert.Equal(t, events, total)
+	assert.Equal(t, events*2, countRows(t, db, "SELECT COUNT(*) FROM webhook_deliveries"))
+	assert.Equal(t, 0, countRows(t, db, "SELECT COUNT(*) FROM outbox_events WHERE dispatched_at IS NULL"))
+
+	dispatched, err := Dispatch(ctx, middleware.NewTxAdmin(db))
+	assert.NoError(t, err)
+	assert.Equal(t, 0, dispatched)
+	assert.Equal(t, events*2, countRows(t, db, "SELECT COUNT(*) FROM webhook_deliveries"))
+}
+
+func TestDeliverer_DeliverDue_SQLite(t *testing.T) {
+	db := openOutboxDB(t)
+	server := newWebhookServer(t)
+	ok, fail, bad := setupDeliveries(t, db, server)
+	ctx := context.Background()
+	now := time.Now().UTC().Truncate(time.Second).Add(time.Minute)
+	d := newTestDeliverer(server, &now)
+
+	delivered, err := d.DeliverDue(ctx, db)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, delivered)
+	assert.Equal(t, 3, server.total())
+
+	// 200 は成功
+	state := getDeliveryState(t, db, ok.ID)
+	assert.Equal(t, DeliveryStatusSucceeded, state.status)
+	assert.Equal(t, uint32(1), state.attempts)
+	assert.Equal(t, int64(http.StatusOK), state.responseStatus.Int64)
+	assert.False(t, state.nextAttemptAt.Valid)
+	// 500 は再送を待つ
+	state = getDeliveryState(t, db, fail.ID)
+	assert.Equal(t, DeliveryStatusPending, state.status)
+	assert.Equal(t, uint32(1), state.attempts)
+	assert.Equal(t, int64(http.StatusInternalServerError), state.responseStatus.Int64)
+	assert.True(t, state.errorMessage.Valid)
+	assert.True(t, now.Add(Backoff(1)).Equal(state.nextAttemptAt.Time), state.nextAttemptAt.Time)
+	// 400 は再送しないでデッドレターにする
+	state = getDeliveryState(t, db, bad.ID)
+	assert.Equal(t, DeliveryStatusDead, state.status)
+	assert.Equal(t, uint32(1), state.attempts)
+	assert.Equal(t, int64(http.StatusBadRequest), state.responseStatus.Int64)
+	assert.False(t, state.nextAttemptAt.Valid)
+
+	// 再送の時刻までは送らない
+	delivered, err = d.DeliverDue(ctx, db)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, delivered)
+	assert.Equal(t, 3, server.total())
+
+	// 上限の回数まで再送してデッドレターにする
+	for attempts := uint32(1); attempts < DeliveryMaxAttempts; attempts++ {
+		now = now.Add(Backoff(attempts))
+		_, err = d.DeliverDue(ctx, db)
+		assert.NoError(t, err)
+		state = getDeliveryState(t, db, fail.ID)
+		assert.Equal(t, attempts+1, state.attempts)
+	}
+	assert.Equal(t, DeliveryStatusDead, state.status)
+	assert.False(t, state.nextAttemptAt.Valid)
+	assert.Equal(t, DeliveryMaxAttempts, server.received[fmt.Sprint(getDeliveryID(t, db, fail.ID))])
+
+	now = now.Add(DeliveryMaxBackoff)
+	delivered, err = d.DeliverDue(ctx, db)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, delivered)
+	assert.Equal(t, 2+DeliveryMaxAttempts, server.total())
+}
+
+func TestDeliverer_DeliverDue_Lease_SQLite(t *testing.T) {
+	db := openOutboxDB(t)
+	server := newWebhookServer(t)
+	ok, fail, _ := setupDeliveries(t, db, server)
+	ctx := context.Background()
+	now := time.Now().UTC().Truncate(time.Second).Add(time.Minute)
+
+	// 同時に実行しても各配信は1回だけ送る
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := newTestDeliverer(server, &now).DeliverDue(ctx, db)
+			assert.NoError(t, err)
+		}()
+	}
+	wg.Wait()
+	assert.Len(t, server.received, 3)
+	for id, n := range server.received {
+		assert.Equal(t, 1, n, id)
+	}
+
+	// 配信中のまま結果を記録できなかった配信は、期限が切れるまで他の処理が送らない
+	_, err := db.Exec("UPDATE webhook_deliveries SET next_attempt_at = ? WHERE endpoint_id = ?", now.Add(DeliveryLease), fail.ID)
+	assert.NoError(t, err)
+	now = now.Add(DeliveryLease - time.Second)
+	d := newTestDeliverer(server, &now)
+	_, err = d.DeliverDue(ctx, db)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, server.total())
+
+	now = now.Add(time.Second)
+	_, err = d.DeliverDue(ctx, db)
+	assert.NoError(t, err)
+	assert.Equal(t, 4, server.total())
+	assert.Equal(t, 2, server.received[fmt.Sprint(getDeliveryID(t, db, fail.ID))])
+	assert.Equal(t, 1, server.received[fmt.Sprint(getDeliveryID(t, db, ok.ID))])
+}
+
+func TestReplay_SQLite(t *testing.T) {
+	db := openOutboxDB(t)
+	server := newWebhookServer(t)
+	ok, fail, bad := setupDeliveries(t, db, server)
+	ctx := context.Background()
+	now := time.Now().UTC().Truncate(time.Second).Add(time.Minute)
+	_, err := newTestDeliverer(server, &now).DeliverDue(ctx, db)
+	assert.NoError(t, err)
+
+	// デッドレターは再送の対象に戻す
+	assert.NoError(t, Replay(ctx, db, getDeliveryID(t, db, bad.ID)))
+	state := getDeliveryState(t, db, bad.ID)
+	assert.Equal(t, DeliveryStatusPending, state.status)
+	assert.Equal(t, uint32(0), state.attempts)
+	assert.True(t, state.nextAttemptAt.Valid)
+
+	// デッドレターではない配信は戻さない
+	assert.Equal(t, ErrDeadLetterNotFound, Replay(ctx, db, getDeliveryID(t, db, ok.ID)))
+	assert.Equal(t, ErrDeadLetterNotFound, Replay(ctx, db, getDeliveryID(t, db, fail.ID)))
+	assert.Equal(t, ErrDeadLetterNotFound, Replay(ctx, db, 999))
+
+	// 送り先の Webhook が削除されたデッドレターは戻さない
+	assert.NoError(t, DeleteEndpoint(ctx, middleware.NewTxAdmin(db), fail.ID))
+	assert.Equal(t, ErrDeadLetterNotFound, Replay(ctx, db, getDeliveryID(t, db, fail.ID)))
+	assert.Equal(t, DeliveryStatusDead, getDeliveryState(t, db, fail.ID).status)
+}
+
+func TestDeleteEndpoint_SQLite(t *testing.T) {
+	db := openOutboxDB(t)
+	server := newWebhookServer(t)
+	ok, fail, bad := setupDeliveries(t, db, server)
+	ctx := context.Background()
+	now := time.Now().UTC().Truncate(time.Second).Add(time.Minute)
+	_, err := newTestDeliverer(server, &now).DeliverDue(ctx, db)
+	assert.NoError(t, err)
+	txAdmin := middleware.NewTxAdmin(db)
+
+	// 再送を待つ配信はデッドレターにする
+	assert.NoError(t, DeleteEndpoint(ctx, txAdmin, fail.ID))
+	state := getDeliveryState(t, db, fail.ID)
+	assert.Equal(t, DeliveryStatusDead, state.status)
+	assert.Equal(t, "endpoint deleted", state.errorMessage.String)
+	assert.False(t, state.nextAttemptAt.Valid)
+
+	// 終わった配信はそのまま
+	assert.NoError(t, DeleteEndpoint(ctx, txAdmin, ok.ID))
+	assert.Equal(t, DeliveryStatusSucceeded, getDeliveryState(t, db, ok.ID).status)
+	assert.NoError(t, DeleteEndpoint(ctx, txAdmin, bad.ID))
+	assert.Equal(t, int64(http.StatusBadRequest), getDeliveryState(t, db, bad.ID).responseStatus.Int64)
+
+	assert.True(t, errors.Is(DeleteEndpoint(ctx, txAdmin, fail.ID), ErrEndpointNotFound))
+	endpoints, err := GetEndpoints(ctx, db)
+	assert.NoError(t, err)
+	assert.Empty(t, endpoints)
+
+	// 削除した Webhook へは送らない
+	now = now.Add(DeliveryMaxBackoff)
+	delivered, err := newTestDeliverer(server, &now).DeliverDue(ctx, db)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, delivered)
+	assert.Equal(t, 3, server.total())
+}
@@ -0,0 +1,40 @@
+package outbox
+
+import (
+	"corona-api/src/modules/date"
+	"corona-api/src/modules/patient"
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestBackoff(t *testing.T) {
+	tests := []struct {
+		attempts uint32
+		want     time.Duration
+	}{
+		{attempts: 1, want: 30 * time.Second},
+		{attempts: 2, want: time.Minute},
+		{attempts: 4, want: 4 * time.Minute},
+		{attempts: 7, want: 32 * time.Minute},
+		{attempts: 20, want: DeliveryMaxBackoff},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(strconv.Itoa(int(tt.attempts)), func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.want, Backoff(tt.attempts))
+		})
+	}
+}
+
+func TestNewPatientDetailsUpdated(t *testing.T) {
+	jst := time.FixedZone("Asia/Tokyo", 9*60*60)
+	event := NewPatientDetailsUpdated(12, patient.ChangeSet{StartDate: date.MustParse("20220901"), EndDate: date.MustParse("20220927"), Areas: []string{"北海道", "東京都"}, Rows: 94}, time.Date(2022, 9, 28, 9, 0, 0, 500, jst))
+
+	body, err := json.Marshal(event)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"event":"patient_details.updated","run_id":12,"start_date":"2022-09-01","end_date":"2022-09-27","areas":["北海道","東京都"],"rows_changed":94,"occurred_at":"2022-09-28T00:00:00Z"}`, string(body))
+}
@@ -0,0 +1,231 @@
+package patient
+
+import (
+	"corona-api/src/modules/date"
+	"math"
+	"sort"
+)
+
+const (
+	// AnomalyKindSpike は予測より多い(まとめて報告された日など)
+	AnomalyKindSpike = "spike"
+	// AnomalyKindDrop は予測より少ない(報告されなかった日など)
+	AnomalyKindDrop = "drop"
+)
+
+const (
+	DefaultAnomalyWindow       = 7
+	DefaultAnomalyScaleWindow  = 56
+	DefaultAnomalyThreshold    = 3.5
+	DefaultAnomalyMinDeviation = 10
+)
+
+// AnomalyHistoryDays は異常値の検出に必要な期間の前後の日数
+const AnomalyHistoryDays = DefaultAnomalyScaleWindow / 2
+
+// anomalyMinScale は残差のばらつきの下限(対数。値がほとんど変わらない時期に小さな揺らぎを異常値にしない)
+const anomalyMinScale = 0.05
+
+// madScale は MAD を正規分布の標準偏差に換算する係数
+const madScale = 1.4826
+
+// AnomalyOptions は異常値の検出の設定
+type AnomalyOptions struct {
+	// Window は基準にする移動中央値の日数
+	Window int
+	// ScaleWindow は曜日ごとの傾向と残差のばらつきを求める日数
+	ScaleWindow int
+	// Threshold はロバストzスコアの絶対値の下限
+	Threshold float64
+	// MinDeviation は予測との差(人数)の下限(感染者が少ない時期の揺らぎを除く)
+	MinDeviation float64
+}
+
+func DefaultAnomalyOptions() AnomalyOptions {
+	return AnomalyOptions{
+		Window:       DefaultAnomalyWindow,
+		ScaleWindow:  DefaultAnomalyScaleWindow,
+		Threshold:    DefaultAnomalyThreshold,
+		MinDeviation: DefaultAnomalyMinDeviation,
+	}
+}
+
+// Anomaly は報告の偏りなどで前後の日や同じ曜日から外れた値
+type Anomaly struct {
+	Date  date.Date `json:"date"`
+	Area  string    `json:"area"`
+	Value uint32    `json:"value"`
+	// Expected は移動中央値と曜日ごとの傾向から予測した値
+	Expected float64 `json:"expected"`
+	// Score はロバストzスコア
+	Score float64 `json:"score"`
+	Kind  string  `json:"kind"`
+}
+
+// DetectAnomalies は都道府県ごとに日ごとの感染者数の異常値を検出し、都道府県、日付の順に返す
+// 曜日による増減を除くため、移動中央値との残差(対数)から同じ曜日の残差の中央値を引いてロバストzスコアを求める
+// period が未指定(ゼロ値)でなければ period 内の異常値だけを返す。前後のデータも検出に使う
+func DetectAnomalies(patientDetails []Detail, period date.Range, opts AnomalyOptions) []Anomaly {
+	byArea := map[string][]Detail{}
+	var areas []string
+	for _, pd := range patientDetails {
+		if _, ok := byArea[pd.Area]; !ok {
+			areas = append(areas, pd.Area)
+		}
+		byArea[pd.Area] = append(byArea[pd.Area], pd)
+	}
+	sort.Strings(areas)
+
+	anomalies := []Anomaly{}
+	for _, area := range areas {
+		for _, a := range detectAreaAnomalies(byArea[area], opts) {
+			if period.Start.IsZero() || period.Contains(a.Date) {
+				anomalies = append(anomalies, a)
+			}
+		}
+	}
+	return anomalies
+}
+
+func detectAreaAnomalies(patientDetails []Detail, opts AnomalyOptions) []Anomaly {
+	// 最初の日から最後の日までの日ごとの値(データが無い日は NaN)
+	var first, last date.Date
+	for _, pd := range patientDetails {
+		if first.IsZero() || pd.Date.Before(first) {
+			first = pd.Date
+		}
+		if last.IsZero() || pd.Date.After(last) {
+			last = pd.Date
+		}
+	}
+	n := date.Range{Start: first, End: last}.Days()
+	values := make([]float64, n)
+	for i := range values {
+		values[i] = math.NaN()
+	}
+	for _, pd := range patientDetails {
+		values[pd.Date.Sub(first)] = float64(pd.Value)
+	}
+
+	// 曜日による増減を比で扱えるように対数にする
+	logs := make([]float64, n)
+	for i, v := range values {
+		logs[i] = math.Log1p(v)
+	}
+	baseline := rollingMedian(logs, opts.Window)
+	residuals := make([]float64, n)
+	for i := range logs {
+		residuals[i] = logs[i] - baseline[i]
+	}
+	// 同じ曜日の残差の中央値
+	weekly := make([]float64, n)
+	for i := range residuals {
+		weekly[i] = windowMedian(residuals, i, opts.ScaleWindow, 7)
+		if math.IsNaN(weekly[i]) {
+			weekly[i] = 0
+		}
+	}
+	adjusted := make([]float64, n)
+	for i := range residuals {
+		adjusted[i] = residuals[i] - weekly[i]
+	}
+
+	var anomalies []Anomaly
+	for i, v := range values {
+		if math.IsNaN(v) || math.IsNaN(adjusted[i]) {
+			continue
+		}
+		scale := math.Max(madScale*windowMAD(adjusted, i, opts.ScaleWindow), anomalyMinScale)
+		score := adjusted[i] / scale
+		expected := math.Expm1(baseline[i] + weekly[i])
+		if math.Abs(score) < opts.Threshold || math.Abs(v-expected) < opts.MinDeviation {
+			continue
+		}
+		kind := AnomalyKindSpike
+		if score < 0 {
+			kind = AnomalyKindDrop
+		}
+		anomalies = append(anomalies, Anomaly{
+			Date:     first.AddDays(i),
+			Area:     patientDetails[0].Area,
+			Value:    uint32(v),
+			Expected: expected,
+			Score:    score,
+			Kind:     kind,
+		})
+	}
+	return anomalies
+}
+
+// rollingMedian は移動中央値。系列の端は隣の期間の中央値との差から求めた傾きで補外する(増加中の最後の日を異常値にしない)
+func rollingMedian(values []float64, window int) []float64 {
+	n := len(values)
+	medians := make([]float64, n)
+	for i := range values {
+		medians[i] = windowMedian(values, i, window, 1)
+	}
+	if n < 2*window {
+		return medians
+	}
+	first := (window - 1) / 2
+	last := n - 1 - (window - 1 - first)
+	head := (medians[first+window] - medians[first]) / float64(window)
+	tail := (medians[last] - medians[last-window]) / float64(window)
+	for i := 0; i < first; i++ {
+		if !math.IsNaN(head) {
+			medians[i] = medians[first] + head*float64(i-first)
+		}
+	}
+	for i := last + 1; i < n; i++ {
+		if !math.IsNaN(tail) {
+			medians[i] = medians[last] + tail*float64(i-last)
+		}
+	}
+	return medians
+}
+
+// windowValues は i を含む window 日間のうち i と step 日ずつ離れた日の値(NaN を除く)
+// 系列の端では日数が変わらないように期間をずらす(7日間なら全ての曜日を含むようにする)
+func windowValues(values []float64, i int, window int, step int) []float64 {
+	from := i - (window-1)/2
+	to := from + window - 1
+	if to > len(values)-1 {
+		from -= to - (len(values) - 1)
+		to = len(values) - 1
+	}
+	if from < 0 {
+		to -= from
+		from = 0
+	}
+	var selected []float64
+	for k := i - (i-from)/step*step; k <= to && k < len(values); k += step {
+		if !math.IsNaN(values[k]) {
+			selected = append(selected, values[k])
+		}
+	}
+	return selected
+}
+
+// windowMedian は windowValues の中央値(値が無ければ NaN)
+func windowMedian(values []float64, i int, window int, step int) float64 {
+	return median(windowValues(values, i, window, step))
+}
+
+// windowMAD は i を含む window 日間の中央絶対偏差
+func windowMAD(values []float64, i int, window int) float64 {
+	selected := windowValues(values, i, window, 1)
+	m := median(selected)
+	for k, v := range selected {
+		selected[k] = math.Abs(v - m)
+	}
+	return median(selected)
+}
+
+func median(values []float64) float64 {
+	if len(values) == 0 {
+		return math.NaN()
+	}
+	sorted := append([]float64(nil), values...)
+	sort.Float64s(sorted)
+	return *percentile(sorted, 50)
+}
@@ -0,0 +1,85 @@
+package patient
+
+import (
+	"corona-api/src/modules/date"
+	"github.com/stretchr/testify/assert"
+	"math"
+	"testing"
+)
+
+// weeklySeries は増加傾向と曜日による増減(月曜は半分、土曜は7割)がある系列
+func weeklySeries(area string, start date.Date, days int) []Detail {
+	details := make([]Detail, 0, days)
+	for i := 0; i < days; i++ {
+		d := start.AddDays(i)
+		value := 200 * math.Exp(0.01*float64(i))
+		switch d.Weekday() {
+		case 1:
+			value *= 0.5
+		case 6:
+			value *= 0.7
+		}
+		// 曜日と関係ない小さな揺らぎ
+		value *= 1 + 0.05*math.Sin(float64(i)*1.7)
+		details = append(details, Detail{Date: d, Area: area, Value: uint32(math.Round(value)), Country: DefaultCountry})
+	}
+	return details
+}
+
+func TestDetectAnomalies(t *testing.T) {
+	start := date.MustParse("2022-08-01")
+	details := weeklySeries("東京都", start, 120)
+
+	// 曜日による増減は異常値にしない
+	assert.Empty(t, DetectAnomalies(details, date.Range{}, DefaultAnomalyOptions()))
+
+	// 1週間分をまとめて報告した日と、報告が無かった日
+	details[60].Value *= 5
+	details[90].Value = 0
+	anomalies := DetectAnomalies(details, date.Range{}, DefaultAnomalyOptions())
+	assert.Len(t, anomalies, 2)
+	assert.Equal(t, start.AddDays(60), anomalies[0].Date)
+	assert.Equal(t, AnomalyKindSpike, anomalies[0].Kind)
+	assert.True(t, anomalies[0].Score > DefaultAnomalyThreshold)
+	assert.InDelta(t, float64(details[60].Value)/5, anomalies[0].Expected, float64(details[60].Value)/5*0.1)
+	assert.Equal(t, start.AddDays(90), anomalies[1].Date)
+	assert.Equal(t, AnomalyKindDrop, anomalies[1].Kind)
+
+	// 期間外の異常値は返さない
+	anomalies = DetectAnomalies(details, date.Range{Start: start.AddDays(80), End: start.AddDays(119)}, DefaultAnomalyOptions())
+	assert.Len(t, anomalies, 1)
+	assert.Equal(t, start.AddDays(90), anomalies[0].Date)
+}
+
+func TestDetectAnomalies_Growth(t *testing.T) {
+	// 急増中でも最後の日を異常値にしない
+	start := date.MustParse("2022-08-01")
+	details := weeklySeries("大阪府", start, 70)
+	for i := range details {
+		details[i].Value = uint32(float64(details[i].Value) * math.Exp(0.05*float64(i)))
+	}
+	assert.Empty(t, DetectAnomalies(details, date.Range{}, DefaultAnomalyOptions()))
+}
+
+func TestDetectAnomalies_Areas(t *testing.T) {
+	start := date.MustParse("2022-08-01")
+	details := append(weeklySeries("北海道", start, 90), weeklySeries("青森県", start, 90)...)
+	details[100].Value *= 5
+
+	anomalies := DetectAnomalies(details, date.Range{}, DefaultAnomalyOptions())
+	assert.Len(t, anomalies, 1)
+	assert.Equal(t, "青森県", anomalies[0].Area)
+	assert.Equal(t, start.AddDays(10), anomalies[0].Date)
+}
+
+func TestDetectAnomalies_SmallValues(t *testing.T) {
+	// 感染者が少ない時期の揺らぎは予測との差が小さいので異常値にしない
+	start := date.MustParse("2022-08-01")
+	var details []Detail
+	for i := 0; i < 60; i++ {
+		details = append(details, Detail{Date: start.AddDays(i), Area: "鳥取県", Value: uint32(i % 3)})
+	}
+	details[30].Value = 8
+	assert.Empty(t, DetectAnomalies(details, date.Range{}, DefaultAnomalyOptions()))
+	assert.Empty(t, DetectAnomalies(nil, date.Range{}, DefaultAnomalyOptions()))
+}
@@ -0,0 +1,89 @@
+package patient
+
+import (
+	"corona-api/src/modules/date"
+	"sort"
+)
+
+// ChangeSet は取り込み前後で変わったデータの範囲
+type ChangeSet struct {
+	StartDate date.Date
+	EndDate   date.Date
+	Areas     []string
+	// Rows は追加・変更・削除された行数
+	Rows int
+}
+
+func (c ChangeSet) Empty() bool {
+	return c.Rows == 0
+}
+
+// Change は取り込み前後で変わった1行。Before, After はその行がなければ nil
+type Change struct {
+	Date   date.Date
+	Area   string
+	Before *uint32
+	After  *uint32
+}
+
+type detailKey struct {
+	Date date.Date
+	Area string
+}
+
+// Diff は取り込み前後で変わった行を日付、都道府県の順に返す
+func Diff(before []Detail, after []Detail) []Change {
+	values := make(map[detailKey]uint32, len(before))
+	for _, pd := range before {
+		values[detailKey{pd.Date, pd.Area}] = pd.Value
+	}
+
+	var changes []Change
+	for _, pd := range after {
+		key := detailKey{pd.Date, pd.Area}
+		afterValue := pd.Value
+		value, ok := values[key]
+		if !ok {
+			changes = append(changes, Change{Date: key.Date, Area: key.Area, After: &afterValue})
+		} else if value != pd.Value {
+			beforeValue := value
+			changes = append(changes, Change{Date: key.Date, Area: key.Area, Before: &beforeValue, After: &afterValue})
+		}
+		delete(values, key)
+	}
+	// 取り込み後になくなったデータ
+	for key, value := range values {
+		beforeValue := value
+		changes = append(changes, Change{Date: key.Date, Area: key.Area, Before: &beforeValue})
+	}
+
+	sort.Slice(changes, func(i, j int) bool {
+		if changes[i].Date != changes[j].Date {
+			return changes[i].Date.Before(changes[j].Date)
+		}
+		return changes[i].Area < changes[j].Area
+	})
+	return changes
+}
+
+// Changes は取り込み前後のデータを比較する
+func Changes(before []Detail, after []Detail) ChangeSet {
+	changes := Diff(before, after)
+
+	changeSet := ChangeSet{Rows: len(changes), Areas: []string{}}
+	areas := map[string]bool{}
+	for _, change := range changes {
+		if changeSet.StartDate.IsZero() || change.Date.Before(changeSet.StartDate) {
+			changeSet.StartDate = change.Date
+		}
+		if change.Date.After(changeSet.EndDate) {
+			changeSet.EndDate = change.Date
+		}
+		if !areas[change.Area] {
+			areas[change.Area] = true
+			changeSet.Areas = append(changeSet.Areas, change.Area)
+		}
+	}
+	sort.Strings(changeSet.Areas)
+	return changeSet
+}
@@ -0,0 +1,59 @@
+package patient
+
+import (
+	"corona-api/src/modules/date"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestChanges(t *testing.T) {
+	before := []Detail{
+		{date.MustParse("20230101"), "北海道", 10, DefaultCountry},
+		{date.MustParse("20230101"), "東京都", 20, DefaultCountry},
+		{date.MustParse("20230102"), "東京都", 30, DefaultCountry},
+		{date.MustParse("20230103"), "大阪府", 40, DefaultCountry},
+	}
+	after := []Detail{
+		// 変更なし
+		{date.MustParse("20230101"), "北海道", 10, DefaultCountry},
+		// 値の修正
+		{date.MustParse("20230101"), "東京都", 25, DefaultCountry},
+		{date.MustParse("20230102"), "東京都", 30, DefaultCountry},
+		// 追加
+		{date.MustParse("20230104"), "沖縄県", 5, DefaultCountry},
+		// 大阪府の20230103は削除
+	}
+
+	assert.Equal(t, ChangeSet{
+		StartDate: date.MustParse("20230101"),
+		EndDate:   date.MustParse("20230104"),
+		Areas:     []string{"大阪府", "東京都", "沖縄県"},
+		Rows:      3,
+	}, Changes(before, after))
+}
+
+func TestChanges_NoChange(t *testing.T) {
+	details := []Detail{{date.MustParse("20230101"), "北海道", 10, DefaultCountry}}
+
+	changeSet := Changes(details, details)
+	assert.True(t, changeSet.Empty())
+	assert.Equal(t, []string{}, changeSet.Areas)
+}
+
+func TestDiff(t *testing.T) {
+	before := []Detail{
+		{date.MustParse("20230101"), "東京都", 20, DefaultCountry},
+		{date.MustParse("20230103"), "大阪府", 40, DefaultCountry},
+	}
+	after := []Detail{
+		{date.MustParse("20230104"), "沖縄県", 5, DefaultCountry},
+		{date.MustParse("20230101"), "東京都", 25, DefaultCountry},
+	}
+	value := func(v uint32) *uint32 { return &v }
+
+	assert.Equal(t, []Change{
+		{Date: date.MustParse("20230101"), Area: "東京都", Before: value(20), After: value(25)},
+		{Date: date.MustParse("20230103"), Area: "大阪府", Before: value(40)},
+		{Date: date.MustParse("20230104"), Area: "沖縄県", After: value(5)},
+	}, Diff(before, after))
+}
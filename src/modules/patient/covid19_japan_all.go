@@ -0,0 +1,85 @@
+package patient
+
+import (
+	"context"
+	"corona-api/src/modules/date"
+	"encoding/json"
+	"fmt"
+	"github.com/go-resty/resty/v2"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	DefaultCountry     = "日本"
+	Covid19JapanAllURL = "https://opendata.corona.go.jp/api/Covid19JapanAll"
+)
+
+// FetchCovid19JapanAll は外部APIからCovid19JapanAllのJSONファイルを取得する
+func FetchCovid19JapanAll(ctx context.Context) ([]byte, error) {
+	c := resty.New()
+	res, err := c.SetRetryCount(3).
+		SetRetryWaitTime(5 * time.Second).
+		SetRetryMaxWaitTime(20 * time.Second).
+		AddRetryCondition(func(r *resty.Response, err error) bool {
+			return r.StatusCode() != http.StatusOK
+		}).
+		R().
+		SetContext(ctx).
+		Get(Covid19JapanAllURL)
+	if err != nil {
+		return nil, fmt.Errorf("resty.Get() error: %w", err)
+	}
+	if res.StatusCode() != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code: %v", res.StatusCode())
+	}
+	return res.Body(), nil
+}
+
+// Covid19JapanAllResponse は新型コロナウイルス感染症の都道府県別感染者数API(Covid19JapanAll)のレスポンス
+type Covid19JapanAllResponse struct {
+	ErrorInfo ErrorInfo `json:"errorInfo"`
+	ItemList  ItemList  `json:"itemList"`
+}
+
+type ErrorInfo struct {
+	ErrorFlag    string      `json:"errorFlag"`
+	ErrorCode    interface{} `json:"errorCode"`
+	ErrorMessage interface{} `json:"errorMessage"`
+}
+
+type ItemList []struct {
+	Date      string `json:"date"`
+	NameJp    string `json:"name_jp"`
+	Npatients string `json:"npatients"`
+}
+
+// ParseCovid19JapanAll はCovid19JapanAllのJSONをインサート用の構造体へ変換する
+func ParseCovid19JapanAll(file []byte) ([]Detail, error) {
+	var covid19JapanAllResponse Covid19JapanAllResponse
+	err := json.Unmarshal(file, &covid19JapanAllResponse)
+	if err != nil {
+		return nil, fmt.Errorf("json.Unmarshal() error: %w", err)
+	}
+
+	var patientDetails []Detail
+	for _, item := range covid19JapanAllResponse.ItemList {
+		d, err := date.Parse(item.Date)
+		if err != nil {
+			return nil, fmt.Errorf("date.Parse(date): date: %v, %w", item.Date, err)
+		}
+		npatients, err := strconv.Atoi(item.Npatients)
+		if err != nil {
+			return nil, fmt.Errorf("strconv.Atoi(npatients): npatients: %v, %w", item.Npatients, err)
+		}
+		pd := Detail{
+			Date:    d,
+			Area:    item.NameJp,
+			Value:   uint32(npatients),
+			Country: DefaultCountry,
+		}
+		patientDetails = append(patientDetails, pd)
+	}
+	return patientDetails, nil
+}
@@ -0,0 +1,29 @@
+package patient
+
+import (
+	"corona-api/src/modules/date"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestParseCovid19JapanAll(t *testing.T) {
+	file := []byte(`{
+		"errorInfo": {"errorFlag": "0", "errorCode": null, "errorMessage": null},
+		"itemList": [
+			{"date": "2023-01-02", "name_jp": "北海道", "npatients": "2000"},
+			{"date": "2023-01-01", "name_jp": "東京都", "npatients": "1000"}
+		]
+	}`)
+	got, err := ParseCovid19JapanAll(file)
+	assert.NoError(t, err)
+	assert.Equal(t, []Detail{
+		{date.MustParse("20230102"), "北海道", 2000, "日本"},
+		{date.MustParse("20230101"), "東京都", 1000, "日本"},
+	}, got)
+}
+
+func TestParseCovid19JapanAll_InvalidNpatients(t *testing.T) {
+	file := []byte(`{"itemList": [{"date": "2023-01-01", "name_jp": "東京都", "npatients": "-"}]}`)
+	_, err := ParseCovid19JapanAll(file)
+	assert.Error(t, err)
+}
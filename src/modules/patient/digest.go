@@ -0,0 +1,81 @@
+package patient
+
+import (
+	"context"
+	"corona-api/src/middleware"
+	"corona-api/src/modules/date"
+	"sort"
+)
+
+const (
+	DigestTopAreaCount = 5
+	DigestWeekDays     = 7
+)
+
+type AreaValue struct {
+	Area  string
+	Value uint32
+}
+
+// Digest は最新日付時点の感染者数の概要
+type Digest struct {
+	LatestDate date.Date
+	// TopAreas は最新日付の感染者数が多い都道府県
+	TopAreas []AreaValue
+	// WeekTotal は最新日付までの7日間の全国の合計、PreviousWeekTotal はその前の7日間の合計
+	WeekTotal         uint64
+	PreviousWeekTotal uint64
+}
+
+// WeekOverWeek は前週比(%)。前週が0の場合は false を返す
+func (d Digest) WeekOverWeek() (float64, bool) {
+	if d.PreviousWeekTotal == 0 {
+		return 0, false
+	}
+	return (float64(d.WeekTotal) - float64(d.PreviousWeekTotal)) / float64(d.PreviousWeekTotal) * 100, true
+}
+
+// GetDigest は patient_details から最新日付時点の概要を作る
+func GetDigest(ctx context.Context, q middleware.Querier) (Digest, error) {
+	latestDate, err := GetLatestDate(ctx, q)
+	if err != nil {
+		return Digest{}, err
+	}
+
+	// 前週比を出すため2週間分を取得
+	patientDetails, err := GetPatientDetailsByPeriod(ctx, q, date.LastDays(latestDate, 2*DigestWeekDays))
+	if err != nil {
+		return Digest{}, err
+	}
+	return summarizeDigest(patientDetails, latestDate, DigestTopAreaCount), nil
+}
+
+func summarizeDigest(patientDetails []Detail, latestDate date.Date, topAreaCount int) Digest {
+	week := date.LastDays(latestDate, DigestWeekDays)
+	previousWeek := week.Previous()
+
+	digest := Digest{LatestDate: latestDate}
+	for _, pd := range patientDetails {
+		switch {
+		case week.Contains(pd.Date):
+			digest.WeekTotal += uint64(pd.Value)
+		case previousWeek.Contains(pd.Date):
+			digest.PreviousWeekTotal += uint64(pd.Value)
+		}
+		if pd.Date == latestDate {
+			digest.TopAreas = append(digest.TopAreas, AreaValue{Area: pd.Area, Value: pd.Value})
+		}
+	}
+
+	// 感染者数の多い順(同数は都道府県名順)
+	sort.Slice(digest.TopAreas, func(i, j int) bool {
+		if digest.TopAreas[i].Value != digest.TopAreas[j].Value {
+			return digest.TopAreas[i].Value > digest.TopAreas[j].Value
+		}
+		return digest.TopAreas[i].Area < digest.TopAreas[j].Area
+	})
+	if len(digest.TopAreas) > topAreaCount {
+		digest.TopAreas = digest.TopAreas[:topAreaCount]
+	}
+	return digest
+}
@@ -0,0 +1,49 @@
+package patient
+
+import (
+	"corona-api/src/modules/date"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestSummarizeDigest(t *testing.T) {
+	var patientDetails []Detail
+	// 2023-01-01〜2023-01-14 の2週間、前週は毎日10人、直近の週は毎日15人
+	for _, d := range date.LastDays(date.MustParse("2023-01-14"), 14).Dates() {
+		value := uint32(10)
+		if !d.Before(date.MustParse("2023-01-08")) {
+			value = 15
+		}
+		patientDetails = append(patientDetails, Detail{d, "北海道", value, DefaultCountry})
+	}
+	patientDetails = append(patientDetails,
+		Detail{date.MustParse("20230114"), "東京都", 300, DefaultCountry},
+		Detail{date.MustParse("20230114"), "大阪府", 200, DefaultCountry},
+		Detail{date.MustParse("20230114"), "愛知県", 100, DefaultCountry},
+		Detail{date.MustParse("20230114"), "福岡県", 100, DefaultCountry},
+		Detail{date.MustParse("20230114"), "沖縄県", 5, DefaultCountry},
+		// 集計期間外
+		Detail{date.MustParse("20221231"), "東京都", 1000, DefaultCountry},
+	)
+
+	digest := summarizeDigest(patientDetails, date.MustParse("20230114"), DigestTopAreaCount)
+	assert.Equal(t, date.MustParse("20230114"), digest.LatestDate)
+	assert.Equal(t, []AreaValue{
+		{"東京都", 300},
+		{"大阪府", 200},
+		{"愛知県", 100},
+		{"福岡県", 100},
+		{"北海道", 15},
+	}, digest.TopAreas)
+	assert.Equal(t, uint64(15*7+300+200+100+100+5), digest.WeekTotal)
+	assert.Equal(t, uint64(10*7), digest.PreviousWeekTotal)
+
+	ratio, ok := digest.WeekOverWeek()
+	assert.True(t, ok)
+	assert.InDelta(t, (810.0-70.0)/70.0*100, ratio, 0.0001)
+}
+
+func TestDigest_WeekOverWeek_NoPreviousWeek(t *testing.T) {
+	_, ok := Digest{WeekTotal: 10}.WeekOverWeek()
+	assert.False(t, ok)
+}
@@ -0,0 +1,156 @@
+package patient
+
+import (
+	"corona-api/src/modules/date"
+	"fmt"
+	"math"
+	"strings"
+)
+
+// Metric は日付ごとの派生指標
+type Metric string
+
+const (
+	// MetricDayOverDay は前日差
+	MetricDayOverDay Metric = "day_over_day"
+	// MetricDayOverDayPercent は前日比の増減率(%)
+	MetricDayOverDayPercent Metric = "day_over_day_percent"
+	// MetricWeekOverWeek は7日間合計の前週比(1より大きければ増加)
+	MetricWeekOverWeek Metric = "week_over_week"
+	// MetricDoublingTime は前週比から求めた倍加時間(日)。増加していなければ null
+	MetricDoublingTime Metric = "doubling_time"
+	// MetricHalvingTime は前週比から求めた半減時間(日)。減少していなければ null
+	MetricHalvingTime Metric = "halving_time"
+)
+
+// Metrics は指定できる派生指標(出力の列の順)
+var Metrics = []Metric{MetricDayOverDay, MetricDayOverDayPercent, MetricWeekOverWeek, MetricDoublingTime, MetricHalvingTime}
+
+// MetricsHistoryDays は派生指標の計算に必要な期間より前の日数(前週の7日間合計)
+const MetricsHistoryDays = 13
+
+// MetricValues は日付ごとの派生指標の値。計算できない場合は nil(JSON は null)
+type MetricValues map[Metric]*float64
+
+// ParseMetrics はカンマ区切りの派生指標を読み込む。all は全ての指標
+func ParseMetrics(s string) ([]Metric, error) {
+	if s == "all" {
+		return Metrics, nil
+	}
+	var metrics []Metric
+	seen := map[Metric]bool{}
+	for _, name := range strings.Split(s, ",") {
+		metric := Metric(strings.TrimSpace(name))
+		if !metric.valid() {
+			return nil, fmt.Errorf("unknown metric: %q", name)
+		}
+		if !seen[metric] {
+			seen[metric] = true
+			metrics = append(metrics, metric)
+		}
+	}
+	return metrics, nil
+}
+
+func (m Metric) valid() bool {
+	for _, metric := range Metrics {
+		if m == metric {
+			return true
+		}
+	}
+	return false
+}
+
+// ComputeMetrics は period の日付ごとの派生指標を計算する
+// details は1つの都道府県のデータで、period の MetricsHistoryDays 日前からあると全ての日付で計算できる
+func ComputeMetrics(details []Detail, metrics []Metric, period date.Range) map[date.Date]MetricValues {
+	values := map[date.Date]uint32{}
+	for _, pd := range details {
+		values[pd.Date] = pd.Value
+	}
+
+	result := map[date.Date]MetricValues{}
+	for _, d := range period.Dates() {
+		if _, ok := values[d]; !ok {
+			continue
+		}
+		dayOverDay, dayOverDayPercent := dayOverDay(values, d)
+		weekOverWeek := weekOverWeek(values, d)
+		mv := MetricValues{}
+		for _, metric := range metrics {
+			switch metric {
+			case MetricDayOverDay:
+				mv[metric] = dayOverDay
+			case MetricDayOverDayPercent:
+				mv[metric] = dayOverDayPercent
+			case MetricWeekOverWeek:
+				mv[metric] = weekOverWeek
+			case MetricDoublingTime:
+				mv[metric] = doublingTime(weekOverWeek)
+			case MetricHalvingTime:
+				mv[metric] = halvingTime(weekOverWeek)
+			}
+		}
+		result[d] = mv
+	}
+	return result
+}
+
+// dayOverDay は前日差と増減率。前日のデータが無ければ nil、前日が0なら増減率は nil
+func dayOverDay(values map[date.Date]uint32, d date.Date) (*float64, *float64) {
+	previous, ok := values[d.AddDays(-1)]
+	if !ok {
+		return nil, nil
+	}
+	diff := float64(values[d]) - float64(previous)
+	if previous == 0 {
+		return &diff, nil
+	}
+	percent := diff / float64(previous) * 100
+	return &diff, &percent
+}
+
+// weekOverWeek は d までの7日間合計と、その前の7日間合計の比
+func weekOverWeek(values map[date.Date]uint32, d date.Date) *float64 {
+	current, ok := sumOfDays(values, date.LastDays(d, 7))
+	if !ok {
+		return nil
+	}
+	previous, ok := sumOfDays(values, date.LastDays(d, 7).Previous())
+	if !ok || previous == 0 {
+		return nil
+	}
+	ratio := current / previous
+	return &ratio
+}
+
+// sumOfDays は期間の合計。1日でもデータが無ければ ok は false
+func sumOfDays(values map[date.Date]uint32, r date.Range) (float64, bool) {
+	var sum float64
+	for _, d := range r.Dates() {
+		value, ok := values[d]
+		if !ok {
+			return 0, false
+		}
+		sum += float64(value)
+	}
+	return sum, true
+}
+
+// doublingTime は1週間で ratio 倍になるときの倍加時間(7 * ln2 / ln(ratio))
+func doublingTime(ratio *float64) *float64 {
+	if ratio == nil || *ratio <= 1 {
+		return nil
+	}
+	days := 7 * math.Ln2 / math.Log(*ratio)
+	return &days
+}
+
+// halvingTime は1週間で ratio 倍になるときの半減時間
+func halvingTime(ratio *float64) *float64 {
+	if ratio == nil || *ratio >= 1 || *ratio <= 0 {
+		return nil
+	}
+	days := 7 * math.Ln2 / -math.Log(*ratio)
+	return &days
+}
@@ -0,0 +1,67 @@
+package patient
+
+import (
+	"corona-api/src/modules/date"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestParseMetrics(t *testing.T) {
+	metrics, err := ParseMetrics("week_over_week, day_over_day,week_over_week")
+	assert.NoError(t, err)
+	assert.Equal(t, []Metric{MetricWeekOverWeek, MetricDayOverDay}, metrics)
+
+	metrics, err = ParseMetrics("all")
+	assert.NoError(t, err)
+	assert.Equal(t, Metrics, metrics)
+
+	_, err = ParseMetrics("day_over_day,unknown")
+	assert.Error(t, err)
+}
+
+func TestComputeMetrics(t *testing.T) {
+	// 前週は毎日100人、今週は毎日200人(1週間で2倍)
+	var details []Detail
+	for i, d := range date.LastDays(date.MustParse("2023-01-14"), 14).Dates() {
+		value := uint32(100)
+		if i >= 7 {
+			value = 200
+		}
+		details = append(details, Detail{Date: d, Area: "東京都", Value: value})
+	}
+
+	period := date.Range{Start: date.MustParse("2023-01-08"), End: date.MustParse("2023-01-14")}
+	result := ComputeMetrics(details, Metrics, period)
+	assert.Len(t, result, 7)
+
+	// 1月8日は前日から100人増えて100%増
+	first := result[date.MustParse("2023-01-08")]
+	assert.Equal(t, 100.0, *first[MetricDayOverDay])
+	assert.Equal(t, 100.0, *first[MetricDayOverDayPercent])
+	// 前の7日間が揃わない
+	assert.Nil(t, first[MetricWeekOverWeek])
+
+	last := result[date.MustParse("2023-01-14")]
+	assert.Equal(t, 0.0, *last[MetricDayOverDay])
+	assert.Equal(t, 0.0, *last[MetricDayOverDayPercent])
+	assert.Equal(t, 2.0, *last[MetricWeekOverWeek])
+	assert.InDelta(t, 7.0, *last[MetricDoublingTime], 1e-9)
+	assert.Nil(t, last[MetricHalvingTime])
+}
+
+func TestComputeMetrics_Halving(t *testing.T) {
+	var details []Detail
+	for i, d := range date.LastDays(date.MustParse("2023-01-14"), 14).Dates() {
+		value := uint32(400)
+		if i >= 7 {
+			value = 100
+		}
+		details = append(details, Detail{Date: d, Value: value})
+	}
+	// 1週間で1/4なので半減時間は3.5日
+	result := ComputeMetrics(details, []Metric{MetricDoublingTime, MetricHalvingTime}, date.LastDays(date.MustParse("2023-01-14"), 1))
+	last := result[date.MustParse("2023-01-14")]
+	assert.Nil(t, last[MetricDoublingTime])
+	assert.InDelta(t, 3.5, *last[MetricHalvingTime], 1e-9)
+	assert.Len(t, last, 2)
+}
@@ -2,10 +2,12 @@ package patient
 
 import (
 	"context"
+	"corona-api/src/middleware"
+	"corona-api/src/modules/date"
 	"database/sql"
 	"encoding/json"
+	"errors"
 	"fmt"
-	"strconv"
 	"sync"
 )
 
@@ -14,14 +16,21 @@ const (
 )
 
 type Detail struct {
-	Date    uint32
+	Date    date.Date
 	Area    string
 	Value   uint32
 	Country string
 }
 
-func GetPatientDetailsByPeriodAndArea(db *sql.DB, area string, startDate uint32, endDate uint32) ([]Detail, error) {
-	rows, err := db.Query("SELECT  date, area, value, country FROM patient_details WHERE area = ? AND date BETWEEN ? AND ?", area, startDate, endDate)
+// CalendarDay は日付ごとの曜日と祝日のフラグ(土日や祝日は報告数が少ないため)
+type CalendarDay struct {
+	Weekday     string `json:"weekday"`
+	IsHoliday   bool   `json:"is_holiday"`
+	HolidayName string `json:"holiday_name,omitempty"`
+}
+
+func GetPatientDetailsByPeriodAndArea(db *sql.DB, area string, period date.Range) ([]Detail, error) {
+	rows, err := db.Query("SELECT  date, area, value, country FROM patient_details WHERE area = ? AND date BETWEEN ? AND ?", area, period.Start, period.End)
 	if err != nil {
 		return []Detail{}, fmt.Errorf("db.Query() error: %v", err)
 	}
@@ -43,11 +52,23 @@ func GetPatientDetailsByPeriodAndArea(db *sql.DB, area string, startDate uint32,
 	return patientDetails, nil
 }
 
-func GeneratePatientDetailsResponse(patientDetails []Detail) ([]byte, error) {
+// GeneratePatientDetailsResponse はレスポンスを作る。period は解決した検索期間、metrics は指定された派生指標(無ければ nil)
+// anomalies は期間内の異常値(報告の偏りなど)
+func GeneratePatientDetailsResponse(patientDetails []Detail, period date.Range, metrics map[date.Date]MetricValues, anomalies []Anomaly) ([]byte, error) {
 	body := map[string]interface{}{}
+	body["period"] = period
+	body["statistics"] = Describe(patientDetails, period)
+	if anomalies == nil {
+		anomalies = []Anomaly{}
+	}
+	body["anomalies"] = anomalies
+	if metrics != nil {
+		body["metrics"] = createMetrics(metrics)
+	}
 
 	// 日付データを作成
 	body = createDate(patientDetails, body)
+	body["calendar"] = createCalendar(patientDetails)
 
 	// 並行処理でデータを取得
 	var wg sync.WaitGroup
@@ -138,8 +159,29 @@ func createArea(patientDetails []Detail) (string, error) {
 
 func createDate(patientDetails []Detail, body map[string]interface{}) map[string]interface{} {
 	for _, pd := range patientDetails {
-		dateString := strconv.Itoa(int(pd.Date))
-		body[dateString] = pd.Value
+		body[pd.Date.Format(date.Layout)] = pd.Value
+	}
+	return body
+}
+
+func createCalendar(patientDetails []Detail) map[string]CalendarDay {
+	calendar := map[string]CalendarDay{}
+	for _, pd := range patientDetails {
+		name, isHoliday := pd.Date.HolidayName()
+		calendar[pd.Date.Format(date.Layout)] = CalendarDay{
+			Weekday:     pd.Date.Weekday().String(),
+			IsHoliday:   isHoliday,
+			HolidayName: name,
+		}
+	}
+	return calendar
+}
+
+// createMetrics は派生指標を日付(yyyymmdd)をキーにする
+func createMetrics(metrics map[date.Date]MetricValues) map[string]MetricValues {
+	body := map[string]MetricValues{}
+	for d, values := range metrics {
+		body[d.Format(date.Layout)] = values
 	}
 	return body
 }
@@ -152,8 +194,12 @@ func createSum(patientDetails []Detail) uint32 {
 	return sum
 }
 
+// createAverage は平均。データが無い場合は0にする(NaN は JSON にできないため)
 func createAverage(patientDetails []Detail) float64 {
 	patientDetailsLength := len(patientDetails)
+	if patientDetailsLength == 0 {
+		return 0
+	}
 	var sum uint32
 	for _, pd := range patientDetails {
 		sum += pd.Value
@@ -161,38 +207,126 @@ func createAverage(patientDetails []Detail) float64 {
 	return float64(sum) / float64(patientDetailsLength)
 }
 
-func InsertPatientDetails(db *sql.DB, patientDetails []Detail) error {
-	// DBトランザクション開始
-	tx, err := db.Begin()
-	defer tx.Rollback()
+// InsertPatientDetails は patient_details を入れ替え、削除した件数を返す
+func InsertPatientDetails(ctx context.Context, txAdmin *middleware.TxAdmin, patientDetails []Detail) (int64, error) {
+	var deleted int64
+	// DBトランザクション内で入れ替える
+	err := txAdmin.Transaction(ctx, func(ctx context.Context) error {
+		q := txAdmin.Querier(ctx)
+
+		// DBを全件削除(TRUNCATEは暗黙的にコミットされるためDELETEを使う)
+		patientDetailsTableName := "patient_details"
+		res, err := q.ExecContext(ctx, "DELETE FROM "+patientDetailsTableName)
+		if err != nil {
+			return err
+		}
+		deleted, err = res.RowsAffected()
+		if err != nil {
+			return err
+		}
+
+		// DBに保存
+		stmt, err := q.PrepareContext(ctx, "INSERT INTO  patient_details (date, area, value, country) VALUES (?,?,?,?)")
+		if err != nil {
+			return err
+		}
+		defer stmt.Close()
+
+		for _, pd := range patientDetails {
+			_, err := stmt.ExecContext(ctx, pd.Date, pd.Area, pd.Value, pd.Country)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
 	if err != nil {
-		return err
+		return 0, err
 	}
+	return deleted, nil
+}
 
-	// DBをTRUNCATE
-	patientDetailsTableName := "patient_details"
-	_, err = tx.Exec("TRUNCATE " + patientDetailsTableName)
+// GetLatestDateByArea は都道府県ごとの最新の日付を取得する
+func GetLatestDateByArea(ctx context.Context, q middleware.Querier) (map[string]date.Date, error) {
+	rows, err := q.QueryContext(ctx, "SELECT area, MAX(date) FROM patient_details GROUP BY area")
 	if err != nil {
-		return err
+		return nil, fmt.Errorf("db.Query() error: %v", err)
 	}
+	defer rows.Close()
+
+	latestDates := map[string]date.Date{}
+	for rows.Next() {
+		var area string
+		var latestDate date.Date
+		if err := rows.Scan(&area, &latestDate); err != nil {
+			return nil, fmt.Errorf("rows.Scan() error: %v", err)
+		}
+		latestDates[area] = latestDate
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows.Err() error: %v", err)
+	}
+	return latestDates, nil
+}
+
+var ErrNoPatientDetails = errors.New("patient details not found")
+
+// GetLatestDate は全ての都道府県で最新の日付を取得する
+func GetLatestDate(ctx context.Context, q middleware.Querier) (date.Date, error) {
+	var latestDate date.Date
+	if err := q.QueryRowContext(ctx, "SELECT MAX(date) FROM patient_details").Scan(&latestDate); err != nil {
+		return date.Date{}, fmt.Errorf("row.Scan() error: %v", err)
+	}
+	if latestDate.IsZero() {
+		return date.Date{}, ErrNoPatientDetails
+	}
+	return latestDate, nil
+}
+
+// GetLatestDateOfArea は都道府県の最新の日付を取得する
+func GetLatestDateOfArea(ctx context.Context, q middleware.Querier, area string) (date.Date, error) {
+	var latestDate date.Date
+	if err := q.QueryRowContext(ctx, "SELECT MAX(date) FROM patient_details WHERE area = ?", area).Scan(&latestDate); err != nil {
+		return date.Date{}, fmt.Errorf("row.Scan() error: %v", err)
+	}
+	if latestDate.IsZero() {
+		return date.Date{}, ErrNoPatientDetails
+	}
+	return latestDate, nil
+}
+
+// GetPatientDetailsByPeriod は全ての都道府県の期間内のデータを取得する
+func GetPatientDetailsByPeriod(ctx context.Context, q middleware.Querier, period date.Range) ([]Detail, error) {
+	return queryPatientDetails(ctx, q, "SELECT date, area, value, country FROM patient_details WHERE date BETWEEN ? AND ?", period.Start, period.End)
+}
+
+// GetPatientDetailsByArea は都道府県の全ての期間のデータを取得する
+func GetPatientDetailsByArea(ctx context.Context, q middleware.Querier, area string) ([]Detail, error) {
+	return queryPatientDetails(ctx, q, "SELECT date, area, value, country FROM patient_details WHERE area = ? ORDER BY date", area)
+}
+
+// GetAllPatientDetails は全てのデータを取得する
+func GetAllPatientDetails(ctx context.Context, q middleware.Querier) ([]Detail, error) {
+	return queryPatientDetails(ctx, q, "SELECT date, area, value, country FROM patient_details")
+}
 
-	// DBに保存
-	stmt, err := tx.Prepare("INSERT INTO  patient_details (date, area, value, country) VALUES (?,?,?,?)")
+func queryPatientDetails(ctx context.Context, q middleware.Querier, query string, args ...interface{}) ([]Detail, error) {
+	rows, err := q.QueryContext(ctx, query, args...)
 	if err != nil {
-		return err
+		return nil, fmt.Errorf("db.Query() error: %v", err)
 	}
-	defer stmt.Close()
+	defer rows.Close()
 
-	for _, pd := range patientDetails {
-		_, err := stmt.Exec(pd.Date, pd.Area, pd.Value, pd.Country)
-		if err != nil {
-			return err
+	var patientDetails []Detail
+	for rows.Next() {
+		var pd Detail
+		if err := rows.Scan(&pd.Date, &pd.Area, &pd.Value, &pd.Country); err != nil {
+			return nil, fmt.Errorf("rows.Scan() error: %v", err)
 		}
+		patientDetails = append(patientDetails, pd)
 	}
-
-	// SQLが正常に実行できたらコミット
-	if err := tx.Commit(); err != nil {
-		return err
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows.Err() error: %v", err)
 	}
-	return nil
+	return patientDetails, nil
 }
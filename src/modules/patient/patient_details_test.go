@@ -1,6 +1,7 @@
 package patient
 
 import (
+	"corona-api/src/modules/date"
 	"fmt"
 	"github.com/stretchr/testify/assert"
 	"testing"
@@ -10,9 +11,9 @@ import (
 // Testifyを使ったアサーション
 func TestCreateSum(t *testing.T) {
 	patientDetails := []Detail{
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
 	}
 	expected := uint32(6000)
 	actual := createSum(patientDetails)
@@ -22,9 +23,9 @@ func TestCreateSum(t *testing.T) {
 // シンプルな書き方
 func TestCreateSum2(t *testing.T) {
 	patientDetails := []Detail{
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
 	}
 	want := uint32(6000)
 	got := createSum(patientDetails)
@@ -63,7 +64,7 @@ func Test_createArea(t *testing.T) {
 			name: "normal",
 			args: args{
 				patientDetails: []Detail{
-					{Date: 202301, Area: "北海道"},
+					{Date: date.MustParse("2023-01-01"), Area: "北海道"},
 				},
 			},
 			want:    "北海道",
@@ -73,7 +74,7 @@ func Test_createArea(t *testing.T) {
 			name: "area is empty",
 			args: args{
 				patientDetails: []Detail{
-					{Date: 202301, Area: ""},
+					{Date: date.MustParse("2023-01-01"), Area: ""},
 				},
 			},
 			want:    "",
@@ -83,7 +84,7 @@ func Test_createArea(t *testing.T) {
 			name: "area is zero value",
 			args: args{
 				patientDetails: []Detail{
-					{Date: 202301},
+					{Date: date.MustParse("2023-01-01")},
 				},
 			},
 			want:    "",
@@ -103,2008 +104,2021 @@ func Test_createArea(t *testing.T) {
 	}
 }
 
+func Test_createCalendar(t *testing.T) {
+	calendar := createCalendar([]Detail{
+		{Date: date.MustParse("2023-01-01"), Area: "北海道", Value: 100},
+		{Date: date.MustParse("2023-01-02"), Area: "北海道", Value: 200},
+		{Date: date.MustParse("2023-01-04"), Area: "北海道", Value: 300},
+	})
+	assert.Equal(t, map[string]CalendarDay{
+		"20230101": {Weekday: "Sunday", IsHoliday: true, HolidayName: "元日"},
+		"20230102": {Weekday: "Monday", IsHoliday: true, HolidayName: "振替休日"},
+		"20230104": {Weekday: "Wednesday", IsHoliday: false},
+	}, calendar)
+}
+
 func GetPatientDetailsMock() []Detail {
 	return []Detail{
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202201, "北海道", 1000, "日本"},
-		{202202, "北海道", 2000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202203, "北海道", 3000, "日本"},
-		{202203, "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-01"), "北海道", 1000, "日本"},
+		{date.MustParse("2022-01-02"), "北海道", 2000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
+		{date.MustParse("2022-01-03"), "北海道", 3000, "日本"},
 	}
 }
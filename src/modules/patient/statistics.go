@@ -0,0 +1,105 @@
+package patient
+
+import (
+	"corona-api/src/modules/date"
+	"math"
+	"sort"
+)
+
+// DatedValue は日付と値(最小値・最大値の日付)
+type DatedValue struct {
+	Date  date.Date `json:"date"`
+	Value uint32    `json:"value"`
+}
+
+// Statistics は期間内の感染者数の記述統計
+// データが無い期間では Count が0になり、値は nil(JSON は null)になる
+type Statistics struct {
+	// Count はデータがある日数、MissingDays は期間内でデータが無い日数
+	Count       int         `json:"count"`
+	MissingDays int         `json:"missing_days"`
+	Sum         uint64      `json:"sum"`
+	Mean        *float64    `json:"mean"`
+	Min         *DatedValue `json:"min"`
+	Max         *DatedValue `json:"max"`
+	Median      *float64    `json:"median"`
+	// StdDev は標本標準偏差(データが2日以上ある場合だけ)
+	StdDev *float64 `json:"std_dev"`
+	P25    *float64 `json:"p25"`
+	P75    *float64 `json:"p75"`
+	P90    *float64 `json:"p90"`
+}
+
+// Describe は period の記述統計を計算する。period が未指定(ゼロ値)ならデータの最初の日から最後の日までにする
+// 同じ日付のデータが複数ある場合は日付ごとのレスポンスや派生指標と同じく後の行の値にし、全ての値を日付ごとに1つの値から求める
+// 同じ値の最小値・最大値は早い日付にする
+func Describe(patientDetails []Detail, period date.Range) Statistics {
+	var s Statistics
+	byDate := map[date.Date]uint32{}
+	for _, pd := range patientDetails {
+		if !period.Start.IsZero() && !period.Contains(pd.Date) {
+			continue
+		}
+		byDate[pd.Date] = pd.Value
+	}
+	dates := make([]date.Date, 0, len(byDate))
+	for d := range byDate {
+		dates = append(dates, d)
+	}
+	sort.Slice(dates, func(i, j int) bool { return dates[i].Before(dates[j]) })
+
+	s.Count = len(dates)
+	if !period.Start.IsZero() {
+		s.MissingDays = period.Days() - s.Count
+	} else if s.Count > 0 {
+		s.MissingDays = date.Range{Start: dates[0], End: dates[len(dates)-1]}.Days() - s.Count
+	}
+	if s.Count == 0 {
+		return s
+	}
+
+	// 平均と分散は Welford の方法で求める(パーセンタイルは並べ替えた値から求める)
+	values := make([]float64, 0, len(dates))
+	var mean, m2 float64
+	for _, d := range dates {
+		v := byDate[d]
+		value := float64(v)
+		values = append(values, value)
+		s.Sum += uint64(v)
+		delta := value - mean
+		mean += delta / float64(len(values))
+		m2 += delta * (value - mean)
+
+		// 日付の昇順なので同じ値は早い日付のまま
+		if s.Min == nil || v < s.Min.Value {
+			s.Min = &DatedValue{Date: d, Value: v}
+		}
+		if s.Max == nil || v > s.Max.Value {
+			s.Max = &DatedValue{Date: d, Value: v}
+		}
+	}
+
+	s.Mean = &mean
+	if len(values) > 1 {
+		stdDev := math.Sqrt(m2 / float64(len(values)-1))
+		s.StdDev = &stdDev
+	}
+	sort.Float64s(values)
+	s.Median = percentile(values, 50)
+	s.P25 = percentile(values, 25)
+	s.P75 = percentile(values, 75)
+	s.P90 = percentile(values, 90)
+	return s
+}
+
+// percentile は昇順に並んだ values の p パーセンタイル(線形補間。R の type 7、NumPy の既定と同じ)
+func percentile(sorted []float64, p float64) *float64 {
+	if len(sorted) == 0 {
+		return nil
+	}
+	rank := p / 100 * float64(len(sorted)-1)
+	lower := int(math.Floor(rank))
+	upper := int(math.Ceil(rank))
+	value := sorted[lower] + (sorted[upper]-sorted[lower])*(rank-float64(lower))
+	return &value
+}
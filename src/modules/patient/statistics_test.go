@@ -0,0 +1,76 @@
+package patient
+
+import (
+	"corona-api/src/modules/date"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestDescribe(t *testing.T) {
+	// 1月3日はデータが無い
+	details := []Detail{
+		{Date: date.MustParse("2023-01-01"), Value: 30},
+		{Date: date.MustParse("2023-01-02"), Value: 10},
+		{Date: date.MustParse("2023-01-04"), Value: 40},
+		{Date: date.MustParse("2023-01-05"), Value: 10},
+		{Date: date.MustParse("2023-01-06"), Value: 60},
+		// 期間外
+		{Date: date.MustParse("2023-01-08"), Value: 1000},
+	}
+	s := Describe(details, date.Range{Start: date.MustParse("2023-01-01"), End: date.MustParse("2023-01-07")})
+
+	assert.Equal(t, 5, s.Count)
+	assert.Equal(t, 2, s.MissingDays)
+	assert.Equal(t, uint64(150), s.Sum)
+	assert.Equal(t, 30.0, *s.Mean)
+	// 同じ値は早い日付
+	assert.Equal(t, &DatedValue{Date: date.MustParse("2023-01-02"), Value: 10}, s.Min)
+	assert.Equal(t, &DatedValue{Date: date.MustParse("2023-01-06"), Value: 60}, s.Max)
+	assert.Equal(t, 30.0, *s.Median)
+	// 標本標準偏差 sqrt(((0)^2+(-20)^2+10^2+(-20)^2+30^2)/4)
+	assert.InDelta(t, 21.2132, *s.StdDev, 1e-4)
+	assert.Equal(t, 10.0, *s.P25)
+	assert.Equal(t, 40.0, *s.P75)
+	assert.InDelta(t, 52.0, *s.P90, 1e-9)
+}
+
+func TestDescribe_DuplicateDates(t *testing.T) {
+	// 同じ日付の行は後の行の値にする(1月1日は20、1月3日は10)
+	details := []Detail{
+		{Date: date.MustParse("2023-01-01"), Value: 10},
+		{Date: date.MustParse("2023-01-03"), Value: 40},
+		{Date: date.MustParse("2023-01-02"), Value: 30},
+		{Date: date.MustParse("2023-01-01"), Value: 20},
+		{Date: date.MustParse("2023-01-03"), Value: 10},
+	}
+	s := Describe(details, date.Range{Start: date.MustParse("2023-01-01"), End: date.MustParse("2023-01-03")})
+
+	assert.Equal(t, 3, s.Count)
+	assert.Equal(t, 0, s.MissingDays)
+	assert.Equal(t, uint64(60), s.Sum)
+	assert.Equal(t, 20.0, *s.Mean)
+	assert.Equal(t, float64(s.Sum)/float64(s.Count), *s.Mean)
+	assert.Equal(t, &DatedValue{Date: date.MustParse("2023-01-03"), Value: 10}, s.Min)
+	assert.Equal(t, &DatedValue{Date: date.MustParse("2023-01-02"), Value: 30}, s.Max)
+	// 標本標準偏差 sqrt((0^2+10^2+(-10)^2)/2)
+	assert.InDelta(t, 10.0, *s.StdDev, 1e-9)
+	assert.Equal(t, 20.0, *s.Median)
+	assert.Equal(t, 15.0, *s.P25)
+	assert.Equal(t, 25.0, *s.P75)
+}
+
+func TestDescribe_Empty(t *testing.T) {
+	s := Describe(nil, date.Range{Start: date.MustParse("2023-01-01"), End: date.MustParse("2023-01-07")})
+	assert.Equal(t, Statistics{MissingDays: 7}, s)
+
+	// 期間が未指定ならデータの最初の日から最後の日まで
+	s = Describe([]Detail{{Date: date.MustParse("2023-01-01"), Value: 5}, {Date: date.MustParse("2023-01-03"), Value: 7}}, date.Range{})
+	assert.Equal(t, 2, s.Count)
+	assert.Equal(t, 1, s.MissingDays)
+
+	// 1日だけなら標準偏差は計算しない
+	s = Describe([]Detail{{Date: date.MustParse("2023-01-01"), Value: 5}}, date.Range{})
+	assert.Nil(t, s.StdDev)
+	assert.Equal(t, 5.0, *s.P90)
+	assert.Equal(t, 0.0, createAverage(nil))
+}
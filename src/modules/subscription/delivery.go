@@ -0,0 +1,187 @@
+package subscription
+
+import (
+	"context"
+	"corona-api/src/middleware"
+	"corona-api/src/modules/alert"
+	"corona-api/src/modules/date"
+	"corona-api/src/modules/delivery"
+	"corona-api/src/modules/notification"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+const (
+	DeliveryStatusPending   = delivery.StatusPending
+	DeliveryStatusSucceeded = delivery.StatusSucceeded
+	DeliveryStatusFailed    = "failed"
+)
+
+const (
+	EventAlertTriggered = "alert.triggered"
+)
+
+// 再送の設定
+const (
+	DeliveryMaxAttempts = 6
+	DeliveryBaseBackoff = time.Minute
+	DeliveryMaxBackoff  = time.Hour
+	// 配信中の配信を他の処理が取得しないようにする時間
+	DeliveryLease = 5 * time.Minute
+	// 一度に配信する件数
+	DeliveryBatchSize = 100
+)
+
+// deliveryPolicy は購読の配信の再送と記録先
+var deliveryPolicy = delivery.Policy{
+	Table:        "alert_deliveries",
+	MaxAttempts:  DeliveryMaxAttempts,
+	BaseBackoff:  DeliveryBaseBackoff,
+	MaxBackoff:   DeliveryMaxBackoff,
+	Lease:        DeliveryLease,
+	FailedStatus: DeliveryStatusFailed,
+}
+
+// Delivery は購読の Webhook への配信と配信履歴
+type Delivery struct {
+	ID             int64      `json:"id"`
+	SubscriptionID int64      `json:"subscription_id"`
+	Payload        string     `json:"payload"`
+	Status         string     `json:"status"`
+	Attempts       uint32     `json:"attempts"`
+	ResponseStatus int        `json:"response_status,omitempty"`
+	ErrorMessage   string     `json:"error_message,omitempty"`
+	NextAttemptAt  *time.Time `json:"next_attempt_at"`
+	CreatedAt      time.Time  `json:"created_at"`
+	DeliveredAt    *time.Time `json:"delivered_at"`
+}
+
+// Payload は配信の本文
+type Payload struct {
+	Event           string    `json:"event"`
+	SubscriptionID  int64     `json:"subscription_id"`
+	Area            string    `json:"area"`
+	Metric          string    `json:"metric"`
+	Threshold       float64   `json:"threshold"`
+	Date            date.Date `json:"date"`
+	Average         float64   `json:"average"`
+	PreviousAverage float64   `json:"previous_average"`
+	Change          float64   `json:"change"`
+}
+
+func newPayload(a alert.Alert) Payload {
+	return Payload{
+		Event:           EventAlertTriggered,
+		SubscriptionID:  a.RuleID,
+		Area:            a.Area,
+		Metric:          a.Kind,
+		Threshold:       a.Threshold,
+		Date:            a.Date,
+		Average:         a.Average,
+		PreviousAverage: a.PreviousAverage,
+		Change:          a.Change,
+	}
+}
+
+func enqueueDelivery(ctx context.Context, q middleware.Querier, a alert.Alert) (Delivery, error) {
+	payload, err := json.Marshal(newPayload(a))
+	if err != nil {
+		return Delivery{}, fmt.Errorf("json.Marshal() error: %v", err)
+	}
+	now := time.Now().UTC().Truncate(time.Second)
+	d := Delivery{
+		SubscriptionID: a.RuleID,
+		Payload:        string(payload),
+		Status:         DeliveryStatusPending,
+		NextAttemptAt:  &now,
+		CreatedAt:      now,
+	}
+	res, err := q.ExecContext(ctx, "INSERT INTO alert_deliveries (subscription_id, payload, status, next_attempt_at, created_at) VALUES (?,?,?,?,?)",
+		d.SubscriptionID, d.Payload, d.Status, d.NextAttemptAt, d.CreatedAt)
+	if err != nil {
+		return Delivery{}, fmt.Errorf("insert alert_deliveries error: %v", err)
+	}
+	d.ID, err = res.LastInsertId()
+	if err != nil {
+		return Delivery{}, fmt.Errorf("res.LastInsertId() error: %v", err)
+	}
+	return d, nil
+}
+
+// GetDeliveries は購読の配信履歴を新しい順に取得する
+func GetDeliveries(ctx context.Context, q middleware.Querier, subscriptionID int64, limit int) ([]Delivery, error) {
+	rows, err := q.QueryContext(ctx, "SELECT id, subscription_id, payload, status, attempts, response_status, error_message, next_attempt_at, created_at, delivered_at FROM alert_deliveries WHERE subscription_id = ? ORDER BY id DESC LIMIT ?", subscriptionID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("db.Query() error: %v", err)
+	}
+	defer rows.Close()
+
+	deliveries := []Delivery{}
+	for rows.Next() {
+		var d Delivery
+		var responseStatus sql.NullInt64
+		var errorMessage sql.NullString
+		var nextAttemptAt, deliveredAt sql.NullTime
+		if err := rows.Scan(&d.ID, &d.SubscriptionID, &d.Payload, &d.Status, &d.Attempts, &responseStatus, &errorMessage, &nextAttemptAt, &d.CreatedAt, &deliveredAt); err != nil {
+			return nil, fmt.Errorf("rows.Scan() error: %v", err)
+		}
+		d.ResponseStatus = int(responseStatus.Int64)
+		d.ErrorMessage = errorMessage.String
+		if nextAttemptAt.Valid {
+			d.NextAttemptAt = &nextAttemptAt.Time
+		}
+		if deliveredAt.Valid {
+			d.DeliveredAt = &deliveredAt.Time
+		}
+		deliveries = append(deliveries, d)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows.Err() error: %v", err)
+	}
+	return deliveries, nil
+}
+
+// Backoff は attempts 回目の失敗後に次の配信まで待つ時間
+func Backoff(attempts uint32) time.Duration {
+	return deliveryPolicy.Backoff(attempts)
+}
+
+// Deliverer は期限が来た配信を送る
+type Deliverer struct {
+	Client *http.Client
+	Now    func() time.Time
+}
+
+func NewDeliverer() *Deliverer {
+	return &Deliverer{
+		Client: notification.NewWebhookClient(),
+		Now:    time.Now,
+	}
+}
+
+// DeliverDue は期限が来た配信を送り、成功した件数を返す
+func (d *Deliverer) DeliverDue(ctx context.Context, q middleware.Querier) (int, error) {
+	now := d.Now().UTC().Truncate(time.Second)
+	rows, err := q.QueryContext(ctx, "SELECT d.id, d.attempts, d.payload, s.webhook_url, s.secret FROM alert_deliveries d JOIN alert_subscriptions s ON s.id = d.subscription_id WHERE d.status = ? AND d.next_attempt_at <= ? ORDER BY d.id LIMIT ?",
+		DeliveryStatusPending, now, DeliveryBatchSize)
+	if err != nil {
+		return 0, fmt.Errorf("db.Query() error: %v", err)
+	}
+	var dues []delivery.Due
+	for rows.Next() {
+		due := delivery.Due{Event: EventAlertTriggered}
+		if err := rows.Scan(&due.ID, &due.Attempts, &due.Payload, &due.URL, &due.Secret); err != nil {
+			rows.Close()
+			return 0, fmt.Errorf("rows.Scan() error: %v", err)
+		}
+		dues = append(dues, due)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return 0, fmt.Errorf("rows.Err() error: %v", err)
+	}
+	return delivery.Sender{Policy: deliveryPolicy, Client: d.Client, Now: d.Now}.Deliver(ctx, q, dues)
+}
@@ -0,0 +1,196 @@
+package subscription
+
+import (
+	"context"
+	"corona-api/src/middleware"
+	"corona-api/src/modules/alert"
+	"corona-api/src/modules/date"
+	"corona-api/src/modules/patient"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"time"
+)
+
+var ErrSubscriptionNotFound = errors.New("alert subscription not found")
+
+// Subscription は都道府県ごとのアラートの購読
+// Metric は alert のルールの種類(week_over_week, level)
+type Subscription struct {
+	ID            int64     `json:"id"`
+	Area          string    `json:"area"`
+	Metric        string    `json:"metric"`
+	Threshold     float64   `json:"threshold"`
+	WebhookURL    string    `json:"webhook_url"`
+	Secret        string    `json:"-"`
+	Active        bool      `json:"active"`
+	LastFiredDate date.Date `json:"last_fired_date"`
+	CreatedAt     time.Time `json:"created_at"`
+}
+
+func (s Subscription) rule() alert.Rule {
+	return alert.Rule{
+		ID:        s.ID,
+		Name:      fmt.Sprintf("subscription:%d", s.ID),
+		Area:      s.Area,
+		Kind:      s.Metric,
+		Threshold: s.Threshold,
+		Enabled:   true,
+	}
+}
+
+// NewSecret は配信の署名鍵を生成する
+func NewSecret() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("rand.Read() error: %v", err)
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// CreateSubscription は購読を登録する
+func CreateSubscription(ctx context.Context, q middleware.Querier, s *Subscription) error {
+	s.CreatedAt = time.Now().UTC().Truncate(time.Second)
+	res, err := q.ExecContext(ctx, "INSERT INTO alert_subscriptions (area, metric, threshold, webhook_url, secret, created_at) VALUES (?,?,?,?,?,?)",
+		s.Area, s.Metric, s.Threshold, s.WebhookURL, s.Secret, s.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("insert alert_subscriptions error: %v", err)
+	}
+	s.ID, err = res.LastInsertId()
+	if err != nil {
+		return fmt.Errorf("res.LastInsertId() error: %v", err)
+	}
+	return nil
+}
+
+// GetSubscriptions は購読の一覧を取得する。area が空の場合は全ての都道府県
+func GetSubscriptions(ctx context.Context, q middleware.Querier, area string) ([]Subscription, error) {
+	query := "SELECT id, area, metric, threshold, webhook_url, secret, active, last_fired_date, created_at FROM alert_subscriptions"
+	var args []interface{}
+	if area != "" {
+		query += " WHERE area = ?"
+		args = append(args, area)
+	}
+	rows, err := q.QueryContext(ctx, query+" ORDER BY id", args...)
+	if err != nil {
+		return nil, fmt.Errorf("db.Query() error: %v", err)
+	}
+	defer rows.Close()
+
+	subscriptions := []Subscription{}
+	for rows.Next() {
+		var s Subscription
+		if err := rows.Scan(&s.ID, &s.Area, &s.Metric, &s.Threshold, &s.WebhookURL, &s.Secret, &s.Active, &s.LastFiredDate, &s.CreatedAt); err != nil {
+			return nil, fmt.Errorf("rows.Scan() error: %v", err)
+		}
+		subscriptions = append(subscriptions, s)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows.Err() error: %v", err)
+	}
+	return subscriptions, nil
+}
+
+// DeleteSubscription は購読を削除し、未配信の配信を失敗にする
+func DeleteSubscription(ctx context.Context, txAdmin *middleware.TxAdmin, id int64) error {
+	return txAdmin.Transaction(ctx, func(ctx context.Context) error {
+		q := txAdmin.Querier(ctx)
+
+		res, err := q.ExecContext(ctx, "DELETE FROM alert_subscriptions WHERE id = ?", id)
+		if err != nil {
+			return fmt.Errorf("delete alert_subscriptions error: %v", err)
+		}
+		deleted, err := res.RowsAffected()
+		if err != nil {
+			return fmt.Errorf("res.RowsAffected() error: %v", err)
+		}
+		if deleted == 0 {
+			return ErrSubscriptionNotFound
+		}
+
+		// 配信履歴は残す
+		_, err = q.ExecContext(ctx, "UPDATE alert_deliveries SET status = ?, error_message = ?, next_attempt_at = NULL WHERE subscription_id = ? AND status = ?",
+			DeliveryStatusFailed, "subscription deleted", id, DeliveryStatusPending)
+		if err != nil {
+			return fmt.Errorf("update alert_deliveries error: %v", err)
+		}
+		return nil
+	})
+}
+
+// EvaluateLatest は最新日付のデータで全ての購読を評価し、新たに発火した購読の配信を登録する
+func EvaluateLatest(ctx context.Context, txAdmin *middleware.TxAdmin) ([]Delivery, error) {
+	var deliveries []Delivery
+	// 評価と発火状態の更新、配信の登録は同じトランザクションで行う
+	err := txAdmin.Transaction(ctx, func(ctx context.Context) error {
+		q := txAdmin.Querier(ctx)
+
+		subscriptions, err := GetSubscriptions(ctx, q, "")
+		if err != nil {
+			return err
+		}
+		if len(subscriptions) == 0 {
+			return nil
+		}
+
+		latestDate, err := patient.GetLatestDate(ctx, q)
+		if err != nil {
+			return err
+		}
+		patientDetails, err := patient.GetPatientDetailsByPeriod(ctx, q, date.LastDays(latestDate, 2*alert.AverageDays))
+		if err != nil {
+			return err
+		}
+		averages := alert.Averages(patientDetails, latestDate)
+
+		fired, changed := evaluate(subscriptions, averages)
+		for _, s := range changed {
+			_, err := q.ExecContext(ctx, "UPDATE alert_subscriptions SET active = ?, last_fired_date = ? WHERE id = ?", s.Active, s.LastFiredDate, s.ID)
+			if err != nil {
+				return fmt.Errorf("update alert_subscriptions error: %v", err)
+			}
+		}
+		for _, a := range fired {
+			d, err := enqueueDelivery(ctx, q, a)
+			if err != nil {
+				return err
+			}
+			deliveries = append(deliveries, d)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return deliveries, nil
+}
+
+// evaluate は購読を評価し、新たに発火したアラートと発火状態が変わった購読を返す
+func evaluate(subscriptions []Subscription, averages []alert.Average) ([]alert.Alert, []Subscription) {
+	var rules []alert.Rule
+	states := map[alert.StateKey]alert.State{}
+	byID := map[int64]Subscription{}
+	for _, s := range subscriptions {
+		rules = append(rules, s.rule())
+		states[alert.StateKey{RuleID: s.ID, Area: s.Area}] = alert.State{Active: s.Active, LastFiredDate: s.LastFiredDate}
+		byID[s.ID] = s
+	}
+
+	results := alert.Evaluate(rules, averages)
+	fired := alert.Deduplicate(results, states)
+
+	var changed []Subscription
+	for _, result := range results {
+		s := byID[result.RuleID]
+		if result.Triggered == s.Active {
+			continue
+		}
+		if result.Triggered {
+			s.LastFiredDate = result.Date
+		}
+		s.Active = result.Triggered
+		changed = append(changed, s)
+	}
+	return fired, changed
+}
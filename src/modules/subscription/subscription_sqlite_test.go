@@ -0,0 +1,332 @@
+//go:build cgo
+// +build cgo
+
+package subscription
+
+import (
+	"context"
+	"corona-api/src/middleware"
+	"corona-api/src/modules/alert"
+	"corona-api/src/modules/date"
+	"corona-api/src/modules/migration"
+	"corona-api/src/modules/notification"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+)
+
+// openSubscriptionDB はマイグレーションを適用した SQLite を開く(接続の設定は middleware と同じ)
+func openSubscriptionDB(t *testing.T) *sql.DB {
+	path := filepath.Join(t.TempDir(), "corona.db")
+	db, err := sql.Open(middleware.DriverSQLite, fmt.Sprintf("file:%s?_busy_timeout=5000&_journal_mode=WAL&_txlock=immediate", path))
+	assert.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+	migrations, err := migration.Load(filepath.Join("..", "..", "..", migration.SQLiteDir))
+	assert.NoError(t, err)
+	_, err = migration.Apply(context.Background(), db, migrations)
+	assert.NoError(t, err)
+	return db
+}
+
+// webhookServer はパスごとに決めたステータスを返し、配信IDごとの受信回数を数える
+type webhookServer struct {
+	*httptest.Server
+	mu       sync.Mutex
+	received map[string]int
+}
+
+func newWebhookServer(t *testing.T) *webhookServer {
+	s := &webhookServer{received: map[string]int{}}
+	statuses := map[string]int{"/ok": http.StatusOK, "/fail": http.StatusInternalServerError, "/bad": http.StatusBadRequest}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		s.received[r.Header.Get(notification.WebhookDeliveryHeader)]++
+		s.mu.Unlock()
+		w.WriteHeader(statuses[r.URL.Path])
+	}))
+	t.Cleanup(s.Close)
+	return s
+}
+
+func (s *webhookServer) total() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	total := 0
+	for _, n := range s.received {
+		total += n
+	}
+	return total
+}
+
+// newTestDeliverer はローカルの Webhook へ送れるクライアントと、now を返す時計の Deliverer
+func newTestDeliverer(server *webhookServer, now *time.Time) *Deliverer {
+	return &Deliverer{Client: server.Client(), Now: func() time.Time { return *now }}
+}
+
+type deliveryState struct {
+	id             int64
+	status         string
+	attempts       uint32
+	responseStatus sql.NullInt64
+	errorMessage   sql.NullString
+	nextAttemptAt  sql.NullTime
+}
+
+func getDeliveryState(t *testing.T, db *sql.DB, subscriptionID int64) deliveryState {
+	var s deliveryState
+	err := db.QueryRow("SELECT id, status, attempts, response_status, error_message, next_attempt_at FROM alert_deliveries WHERE subscription_id = ?", subscriptionID).
+		Scan(&s.id, &s.status, &s.attempts, &s.responseStatus, &s.errorMessage, &s.nextAttemptAt)
+	assert.NoError(t, err)
+	return s
+}
+
+func getSubscription(t *testing.T, db *sql.DB, id int64) Subscription {
+	subscriptions, err := GetSubscriptions(context.Background(), db, "")
+	assert.NoError(t, err)
+	for _, s := range subscriptions {
+		if s.ID == id {
+			return s
+		}
+	}
+	t.Fatalf("subscription %d not found", id)
+	return Subscription{}
+}
+
+// setupDeliveries は /ok, /fail, /bad の Webhook の購読を登録し、それぞれに1件の配信を登録する
+func setupDeliveries(t *testing.T, db *sql.DB, server *webhookServer) (ok Subscription, fail Subscription, bad Subscription) {
+	ctx := context.Background()
+	subscriptions := []*Subscription{&ok, &fail, &bad}
+	for i, path := range []string{"/ok", "/fail", "/bad"} {
+		*subscriptions[i] = Subscription{Area: "東京都", Metric: alert.RuleKindLevel, Threshold: 100, WebhookURL: server.URL + path, Secret: "secret"}
+		assert.NoError(t, CreateSubscription(ctx, db, subscriptions[i]))
+		_, err := enqueueDelivery(ctx, db, alert.Alert{RuleID: subscriptions[i].ID, Area: "東京都", Kind: alert.RuleKindLevel, Threshold: 100, Date: date.MustParse("20230114"), Average: 150})
+		assert.NoError(t, err)
+	}
+	return ok, fail, bad
+}
+
+// insertPatientDetails は latest までの14日間のうち、前の週を previous 人、最新の週を current 人にする
+func insertPatientDetails(t *testing.T, db *sql.DB, area string, latest date.Date, previous int, current int) {
+	for i := 0; i < 2*alert.AverageDays; i++ {
+		value := previous
+		if i >= alert.AverageDays {
+			value = current
+		}
+		_, err := db.Exec("INSERT INTO patient_details (date, area, value, country) VALUES (?,?,?,?)", latest.AddDays(i-(2*alert.AverageDays-1)), area, value, "Japan")
+		assert.NoError(t, err)
+	}
+}
+
+func TestEvaluateLatest_SQLite(t *testing.T) {
+	db := openSubscriptionDB(t)
+	ctx := context.Background()
+	txAdmin := middleware.NewTxAdmin(db)
+
+	// 購読が無ければデータが無くても評価しない
+	deliveries, err := EvaluateLatest(ctx, txAdmin)
+	assert.NoError(t, err)
+	assert.Empty(t, deliveries)
+
+	latest := date.MustParse("20230114")
+	insertPatientDetails(t, db, "東京都", latest, 100, 200)
+	subscriptions := []*Subscription{
+		{Area: "東京都", Metric: alert.RuleKindLevel, Threshold: 150},
+		{Area: "東京都", Metric: alert.RuleKindWeekOverWeek, Threshold: 30},
+		{Area: "東京都", Metric: alert.RuleKindLevel, Threshold: 1000},
+		// データが無い都道府県は発火しない
+		{Area: "大阪府", Metric: alert.RuleKindLevel, Threshold: 10},
+	}
+	for _, s := range subscriptions {
+		s.WebhookURL = "https://example.com/hooks"
+		s.Secret = "secret"
+		assert.NoError(t, CreateSubscription(ctx, db, s))
+	}
+
+	deliveries, err = EvaluateLatest(ctx, txAdmin)
+	assert.NoError(t, err)
+	if assert.Len(t, deliveries, 2) {
+		assert.Equal(t, subscriptions[0].ID, deliveries[0].SubscriptionID)
+		assert.Equal(t, subscriptions[1].ID, deliveries[1].SubscriptionID)
+		var payload Payload
+		assert.NoError(t, json.Unmarshal([]byte(deliveries[1].Payload), &payload))
+		assert.Equal(t, Payload{Event: EventAlertTriggered, SubscriptionID: subscriptions[1].ID, Area: "東京都", Metric: alert.RuleKindWeekOverWeek, Threshold: 30, Date: latest, Average: 200, PreviousAverage: 100, Change: 100}, payload)
+	}
+	assert.Equal(t, DeliveryStatusPending, getDeliveryState(t, db, subscriptions[0].ID).status)
+	for i, wantActive := range []bool{true, true, false, false} {
+		s := getSubscription(t, db, subscriptions[i].ID)
+		assert.Equal(t, wantActive, s.Active, i)
+		if wantActive {
+			assert.Equal(t, latest, s.LastFiredDate, i)
+		}
+	}
+
+	// 発火中の購読は同じ日に再び配信しない
+	deliveries, err = EvaluateLatest(ctx, txAdmin)
+	assert.NoError(t, err)
+	assert.Empty(t, deliveries)
+
+	// 配信を登録できなかった場合は発火状態の更新も戻す
+	added := &Subscription{Area: "東京都", Metric: alert.RuleKindLevel, Threshold: 100, WebhookURL: "https://example.com/hooks", Secret: "secret"}
+	assert.NoError(t, CreateSubscription(ctx, db, added))
+	_, err = db.Exec("ALTER TABLE alert_deliveries RENAME TO alert_deliveries_tmp")
+	assert.NoError(t, err)
+	_, err = EvaluateLatest(ctx, txAdmin)
+	assert.Error(t, err)
+	assert.False(t, getSubscription(t, db, added.ID).Active)
+
+	_, err = db.Exec("ALTER TABLE alert_deliveries_tmp RENAME TO alert_deliveries")
+	assert.NoError(t, err)
+	deliveries, err = EvaluateLatest(ctx, txAdmin)
+	assert.NoError(t, err)
+	if assert.Len(t, deliveries, 1) {
+		assert.Equal(t, added.ID, deliveries[0].SubscriptionID)
+	}
+	assert.True(t, getSubscription(t, db, added.ID).Active)
+}
+
+func TestDeliverer_DeliverDue_SQLite(t *testing.T) {
+	db := openSubscriptionDB(t)
+	server := newWebhookServer(t)
+	ok, fail, bad := setupDeliveries(t, db, server)
+	ctx := context.Background()
+	now := time.Now().UTC().Truncate(time.Second).Add(time.Minute)
+	d := newTestDeliverer(server, &now)
+
+	delivered, err := d.DeliverDue(ctx, db)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, delivered)
+	assert.Equal(t, 3, server.total())
+
+	// 200 は成功
+	state := getDeliveryState(t, db, ok.ID)
+	assert.Equal(t, DeliveryStatusSucceeded, state.status)
+	assert.Equal(t, uint32(1), state.attempts)
+	assert.Equal(t, int64(http.StatusOK), state.responseStatus.Int64)
+	assert.False(t, state.nextAttemptAt.Valid)
+	// 500 は再送を待つ
+	state = getDeliveryState(t, db, fail.ID)
+	assert.Equal(t, DeliveryStatusPending, state.status)
+	assert.Equal(t, uint32(1), state.attempts)
+	assert.Equal(t, int64(http.StatusInternalServerError), state.responseStatus.Int64)
+	assert.True(t, state.errorMessage.Valid)
+	assert.True(t, now.Add(Backoff(1)).Equal(state.nextAttemptAt.Time), state.nextAttemptAt.Time)
+	// 400 は再送しないで失敗にする
+	state = getDeliveryState(t, db, bad.ID)
+	assert.Equal(t, DeliveryStatusFailed, state.status)
+	assert.Equal(t, uint32(1), state.attempts)
+	assert.Equal(t, int64(http.StatusBadRequest), state.responseStatus.Int64)
+	assert.False(t, state.nextAttemptAt.Valid)
+
+	// 再送の時刻までは送らない
+	delivered, err = d.DeliverDue(ctx, db)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, delivered)
+	assert.Equal(t, 3, server.total())
+
+	// 上限の回数まで再送して失敗にする
+	for attempts := uint32(1); attempts < DeliveryMaxAttempts; attempts++ {
+		now = now.Add(Backoff(attempts))
+		_, err = d.DeliverDue(ctx, db)
+		assert.NoError(t, err)
+		state = getDeliveryState(t, db, fail.ID)
+		assert.Equal(t, attempts+1, state.attempts)
+	}
+	assert.Equal(t, DeliveryStatusFailed, state.status)
+	assert.False(t, state.nextAttemptAt.Valid)
+	assert.Equal(t, DeliveryMaxAttempts, server.received[fmt.Sprint(state.id)])
+
+	now = now.Add(DeliveryMaxBackoff)
+	delivered, err = d.DeliverDue(ctx, db)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, delivered)
+	assert.Equal(t, 2+DeliveryMaxAttempts, server.total())
+}
+
+func TestDeliverer_DeliverDue_Lease_SQLite(t *testing.T) {
+	db := openSubscriptionDB(t)
+	server := newWebhookServer(t)
+	ok, fail, _ := setupDeliveries(t, db, server)
+	ctx := context.Background()
+	now := time.Now().UTC().Truncate(time.Second).Add(time.Minute)
+
+	// 同時に実行しても各配信は1回だけ送る
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := newTestDeliverer(server, &now).DeliverDue(ctx, db)
+			assert.NoError(t, err)
+		}()
+	}
+	wg.Wait()
+	assert.Len(t, server.received, 3)
+	for id, n := range server.received {
+		assert.Equal(t, 1, n, id)
+	}
+
+	// 配信中のまま結果を記録できなかった配信は、期限が切れるまで他の処理が送らない
+	_, err := db.Exec("UPDATE alert_deliveries SET next_attempt_at = ? WHERE subscription_id = ?", now.Add(DeliveryLease), fail.ID)
+	assert.NoError(t, err)
+	now = now.Add(DeliveryLease - time.Second)
+	d := newTestDeliverer(server, &now)
+	_, err = d.DeliverDue(ctx, db)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, server.total())
+
+	now = now.Add(time.Second)
+	_, err = d.DeliverDue(ctx, db)
+	assert.NoError(t, err)
+	assert.Equal(t, 4, server.total())
+	assert.Equal(t, 2, server.received[fmt.Sprint(getDeliveryState(t, db, fail.ID).id)])
+	assert.Equal(t, 1, server.received[fmt.Sprint(getDeliveryState(t, db, ok.ID).id)])
+}
+
+func TestDeleteSubscription_SQLite(t *testing.T) {
+	db := openSubscriptionDB(t)
+	server := newWebhookServer(t)
+	ok, fail, bad := setupDeliveries(t, db, server)
+	ctx := context.Background()
+	now := time.Now().UTC().Truncate(time.Second).Add(time.Minute)
+	_, err := newTestDeliverer(server, &now).DeliverDue(ctx, db)
+	assert.NoError(t, err)
+	txAdmin := middleware.NewTxAdmin(db)
+
+	// 再送を待つ配信は失敗にする
+	assert.NoError(t, DeleteSubscription(ctx, txAdmin, fail.ID))
+	state := getDeliveryState(t, db, fail.ID)
+	assert.Equal(t, DeliveryStatusFailed, state.status)
+	assert.Equal(t, "subscription deleted", state.errorMessage.String)
+	assert.False(t, state.nextAttemptAt.Valid)
+
+	// 終わった配信はそのまま
+	assert.NoError(t, DeleteSubscription(ctx, txAdmin, ok.ID))
+	assert.Equal(t, DeliveryStatusSucceeded, getDeliveryState(t, db, ok.ID).status)
+	assert.NoError(t, DeleteSubscription(ctx, txAdmin, bad.ID))
+	assert.Equal(t, int64(http.StatusBadRequest), getDeliveryState(t, db, bad.ID).responseStatus.Int64)
+
+	assert.True(t, errors.Is(DeleteSubscription(ctx, txAdmin, fail.ID), ErrSubscriptionNotFound))
+	subscriptions, err := GetSubscriptions(ctx, db, "")
+	assert.NoError(t, err)
+	assert.Empty(t, subscriptions)
+
+	// 配信履歴は残す
+	deliveries, err := GetDeliveries(ctx, db, fail.ID, 100)
+	assert.NoError(t, err)
+	assert.Len(t, deliveries, 1)
+
+	// 削除した購読へは送らない
+	now = now.Add(DeliveryMaxBackoff)
+	delivered, err := newTestDeliverer(server, &now).DeliverDue(ctx, db)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, delivered)
+	assert.Equal(t, 3, server.total())
+}
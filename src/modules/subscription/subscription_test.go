@@ -0,0 +1,54 @@
+package subscription
+
+import (
+	"corona-api/src/modules/alert"
+	"corona-api/src/modules/date"
+	"github.com/stretchr/testify/assert"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestEvaluate(t *testing.T) {
+	subscriptions := []Subscription{
+		{ID: 1, Area: "東京都", Metric: alert.RuleKindWeekOverWeek, Threshold: 30},
+		// 発火中なので配信しない
+		{ID: 2, Area: "東京都", Metric: alert.RuleKindLevel, Threshold: 100, Active: true, LastFiredDate: date.MustParse("20230113")},
+		// 条件を外れたので発火状態を戻す
+		{ID: 3, Area: "大阪府", Metric: alert.RuleKindLevel, Threshold: 100, Active: true, LastFiredDate: date.MustParse("20230110")},
+	}
+	averages := []alert.Average{
+		{Area: "東京都", Date: date.MustParse("20230114"), Average: 150, PreviousAverage: 100},
+		{Area: "大阪府", Date: date.MustParse("20230114"), Average: 90, PreviousAverage: 100},
+	}
+
+	fired, changed := evaluate(subscriptions, averages)
+	assert.Len(t, fired, 1)
+	assert.Equal(t, int64(1), fired[0].RuleID)
+	assert.Equal(t, 50.0, fired[0].Change)
+	assert.Equal(t, []Subscription{
+		{ID: 1, Area: "東京都", Metric: alert.RuleKindWeekOverWeek, Threshold: 30, Active: true, LastFiredDate: date.MustParse("20230114")},
+		{ID: 3, Area: "大阪府", Metric: alert.RuleKindLevel, Threshold: 100, Active: false, LastFiredDate: date.MustParse("20230110")},
+	}, changed)
+}
+
+func TestBackoff(t *testing.T) {
+	tests := []struct {
+		attempts uint32
+		want     time.Duration
+	}{
+		{1, time.Minute},
+		{2, 2 * time.Minute},
+		{3, 4 * time.Minute},
+		{6, 32 * time.Minute},
+		{7, time.Hour},
+		{20, time.Hour},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(strconv.Itoa(int(tt.attempts)), func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.want, Backoff(tt.attempts))
+		})
+	}
+}
@@ -0,0 +1,126 @@
+package workflow
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Reference Paths($.a.b や $$.Execution.Id)の簡易実装
+// 配列のインデックスやフィルタ式には対応しない
+
+func splitPath(path string) ([]string, error) {
+	if path == "$" {
+		return nil, nil
+	}
+	if !strings.HasPrefix(path, "$.") {
+		return nil, fmt.Errorf("unsupported path: %v", path)
+	}
+	return strings.Split(strings.TrimPrefix(path, "$."), "."), nil
+}
+
+func getPath(data interface{}, path string) (interface{}, error) {
+	keys, err := splitPath(path)
+	if err != nil {
+		return nil, err
+	}
+	current := data
+	for _, key := range keys {
+		m, ok := current.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("path %v: %v is not an object", path, key)
+		}
+		current, ok = m[key]
+		if !ok {
+			return nil, fmt.Errorf("path %v: %v not found", path, key)
+		}
+	}
+	return current, nil
+}
+
+// setPath は data の path の位置に value を設定したコピーを返す
+func setPath(data interface{}, path string, value interface{}) (interface{}, error) {
+	keys, err := splitPath(path)
+	if err != nil {
+		return nil, err
+	}
+	if len(keys) == 0 {
+		return value, nil
+	}
+	root, ok := copyObject(data)
+	if !ok {
+		return nil, fmt.Errorf("path %v: input is not an object", path)
+	}
+	current := root
+	for _, key := range keys[:len(keys)-1] {
+		child, ok := copyObject(current[key])
+		if !ok {
+			if _, exists := current[key]; exists {
+				return nil, fmt.Errorf("path %v: %v is not an object", path, key)
+			}
+			child = map[string]interface{}{}
+		}
+		current[key] = child
+		current = child
+	}
+	current[keys[len(keys)-1]] = value
+	return root, nil
+}
+
+func copyObject(data interface{}) (map[string]interface{}, bool) {
+	m, ok := data.(map[string]interface{})
+	if !ok {
+		return nil, false
+	}
+	copied := make(map[string]interface{}, len(m))
+	for k, v := range m {
+		copied[k] = v
+	}
+	return copied, true
+}
+
+// resolveParameters は Parameters の ".$" で終わるキーをパスの値に置き換える
+// "$$." で始まるパスはコンテキストオブジェクトを参照する
+func resolveParameters(parameters interface{}, input interface{}, contextObject interface{}) (interface{}, error) {
+	switch p := parameters.(type) {
+	case map[string]interface{}:
+		resolved := make(map[string]interface{}, len(p))
+		for key, value := range p {
+			if !strings.HasSuffix(key, ".$") {
+				v, err := resolveParameters(value, input, contextObject)
+				if err != nil {
+					return nil, err
+				}
+				resolved[key] = v
+				continue
+			}
+			path, ok := value.(string)
+			if !ok {
+				return nil, fmt.Errorf("parameter %v: path must be a string", key)
+			}
+			var v interface{}
+			var err error
+			if strings.HasPrefix(path, "$$") {
+				v, err = getPath(contextObject, strings.TrimPrefix(path, "$"))
+			} else {
+				v, err = getPath(input, path)
+			}
+			if err != nil {
+				return nil, err
+			}
+			resolved[strings.TrimSuffix(key, ".$")] = v
+		}
+		return resolved, nil
+	case []interface{}:
+		resolved := make([]interface{}, 0, len(p))
+		for _, value := range p {
+			v, err := resolveParameters(value, input, contextObject)
+			if err != nil {
+				return nil, err
+			}
+			resolved = append(resolved, v)
+		}
+		return resolved, nil
+	default:
+		return p, nil
+	}
+}
@@ -0,0 +1,451 @@
+package workflow
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+)
+
+const (
+	ExecutionStatusSucceeded = "SUCCEEDED"
+	ExecutionStatusFailed    = "FAILED"
+)
+
+// Step Functions の定義済みエラー名
+const (
+	ErrorAll        = "States.ALL"
+	ErrorTaskFailed = "States.TaskFailed"
+	ErrorRuntime    = "States.Runtime"
+)
+
+const (
+	DefaultRetryIntervalSeconds = 1
+	DefaultRetryMaxAttempts     = 3
+	DefaultRetryBackoffRate     = 2.0
+	DefaultMaxTransitions       = 1000
+)
+
+// TaskHandler はTaskステートから呼ばれる処理(LambdaのペイロードをJSONで受け取り、JSONで返す)
+type TaskHandler func(ctx context.Context, payload []byte) ([]byte, error)
+
+// TaskError はエラー名を指定してTaskを失敗させる
+// エラー名は Retry の ErrorEquals と照合される
+type TaskError struct {
+	Name  string
+	Cause string
+}
+
+func (e *TaskError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Name, e.Cause)
+}
+
+type Execution struct {
+	ID      string
+	Status  string
+	Output  interface{}
+	Error   string
+	Cause   string
+	History []string
+}
+
+type Runner struct {
+	tasks          map[string]TaskHandler
+	Sleep          func(ctx context.Context, d time.Duration) error
+	MaxTransitions int
+}
+
+func NewRunner() *Runner {
+	return &Runner{
+		tasks:          map[string]TaskHandler{},
+		Sleep:          sleep,
+		MaxTransitions: DefaultMaxTransitions,
+	}
+}
+
+// Register はTaskのリソース(Lambda関数名・ARN)に name を含むステートの処理を登録する
+func (r *Runner) Register(name string, h TaskHandler) {
+	r.tasks[name] = h
+}
+
+func (r *Runner) Run(ctx context.Context, sm *StateMachine, executionID string, input []byte) (*Execution, error) {
+	var data interface{}
+	if len(input) == 0 {
+		data = map[string]interface{}{}
+	} else if err := json.Unmarshal(input, &data); err != nil {
+		return nil, fmt.Errorf("invalid execution input: %v", err)
+	}
+
+	execution := &Execution{ID: executionID}
+	contextObject := map[string]interface{}{
+		"Execution": map[string]interface{}{
+			"Id":        executionID,
+			"Input":     data,
+			"StartTime": time.Now().UTC().Format(time.RFC3339),
+		},
+		"StateMachine": map[string]interface{}{
+			"Id": sm.Comment,
+		},
+	}
+
+	name := sm.StartAt
+	for i := 0; ; i++ {
+		if i >= r.MaxTransitions {
+			execution.fail(ErrorRuntime, fmt.Sprintf("max transitions exceeded: %d", r.MaxTransitions))
+			return execution, nil
+		}
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		state := sm.States[name]
+		execution.History = append(execution.History, name)
+		contextObject["State"] = map[string]interface{}{
+			"Name":        name,
+			"EnteredTime": time.Now().UTC().Format(time.RFC3339),
+		}
+
+		switch state.Type {
+		case StateTypeSucceed:
+			output, err := filterInputOutput(state, data)
+			if err != nil {
+				execution.fail(ErrorRuntime, err.Error())
+				return execution, nil
+			}
+			execution.Status = ExecutionStatusSucceeded
+			execution.Output = output
+			return execution, nil
+		case StateTypeFail:
+			execution.fail(state.Error, state.Cause)
+			return execution, nil
+		case StateTypeChoice:
+			input, err := applyInputPath(state, data)
+			if err != nil {
+				execution.fail(ErrorRuntime, err.Error())
+				return execution, nil
+			}
+			next, err := choose(state, input)
+			if err != nil {
+				execution.fail(ErrorRuntime, err.Error())
+				return execution, nil
+			}
+			data, err = applyOutputPath(state, input)
+			if err != nil {
+				execution.fail(ErrorRuntime, err.Error())
+				return execution, nil
+			}
+			name = next
+			continue
+		case StateTypePass, StateTypeTask:
+			output, err := r.runState(ctx, state, data, contextObject)
+			if err != nil {
+				var taskErr *TaskError
+				if !errors.As(err, &taskErr) {
+					taskErr = &TaskError{Name: ErrorRuntime, Cause: err.Error()}
+				}
+
+				// Catch に一致すればエラー情報を ResultPath に設定して遷移する
+				caughtData, next, ok := catch(state, data, taskErr)
+				if !ok {
+					execution.fail(taskErr.Name, taskErr.Cause)
+					return execution, nil
+				}
+				data = caughtData
+				name = next
+				continue
+			}
+			data = output
+		}
+
+		if state.End {
+			execution.Status = ExecutionStatusSucceeded
+			execution.Output = data
+			return execution, nil
+		}
+		name = state.Next
+	}
+}
+
+func (e *Execution) fail(errorName string, cause string) {
+	e.Status = ExecutionStatusFailed
+	e.Error = errorName
+	e.Cause = cause
+}
+
+func (r *Runner) runState(ctx context.Context, state State, data interface{}, contextObject map[string]interface{}) (interface{}, error) {
+	input, err := applyInputPath(state, data)
+	if err != nil {
+		return nil, err
+	}
+	effectiveInput := input
+	if state.Parameters != nil {
+		effectiveInput, err = resolveParameters(state.Parameters, input, contextObject)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	result := effectiveInput
+	if state.Type == StateTypeTask {
+		result, err = r.invokeWithRetry(ctx, state, effectiveInput, contextObject)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	output := input
+	if state.ResultPath == nil {
+		output = result
+	} else {
+		output, err = setPath(input, *state.ResultPath, result)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return applyOutputPath(state, output)
+}
+
+func (r *Runner) invokeWithRetry(ctx context.Context, state State, input interface{}, contextObject map[string]interface{}) (interface{}, error) {
+	attempts := map[int]int{}
+	for {
+		result, err := r.invoke(ctx, state, input)
+		if err == nil {
+			return result, nil
+		}
+		taskErr := toTaskError(err)
+
+		// リトライ条件に一致する最初の Retrier を使う
+		index, retrier, ok := matchRetrier(state.Retry, taskErr.Name)
+		if !ok || attempts[index] >= retrier.maxAttempts() {
+			return nil, taskErr
+		}
+		wait := retrier.interval(attempts[index])
+		attempts[index]++
+		contextObject["State"].(map[string]interface{})["RetryCount"] = attempts[index]
+		if err := r.Sleep(ctx, wait); err != nil {
+			return nil, err
+		}
+	}
+}
+
+func (r *Runner) invoke(ctx context.Context, state State, input interface{}) (interface{}, error) {
+	functionName := state.Resource
+	payload := input
+	isLambdaInvoke := state.Resource == ResourceLambdaInvoke
+	if isLambdaInvoke {
+		parameters, _ := input.(map[string]interface{})
+		functionName, _ = parameters["FunctionName"].(string)
+		payload = parameters["Payload"]
+	}
+
+	handler, err := r.lookup(functionName)
+	if err != nil {
+		return nil, err
+	}
+	payloadBytes, err := json.Marshal(payload)
+	if err != nil {
+		return nil, &TaskError{Name: ErrorRuntime, Cause: err.Error()}
+	}
+	outputBytes, err := handler(ctx, payloadBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	var output interface{}
+	if len(outputBytes) > 0 {
+		if err := json.Unmarshal(outputBytes, &output); err != nil {
+			return nil, &TaskError{Name: ErrorRuntime, Cause: err.Error()}
+		}
+	}
+	if !isLambdaInvoke {
+		return output, nil
+	}
+	// lambda:invoke の結果は Payload に格納される
+	return map[string]interface{}{
+		"ExecutedVersion": "$LATEST",
+		"Payload":         output,
+		"StatusCode":      float64(200),
+	}, nil
+}
+
+func (r *Runner) lookup(functionName string) (TaskHandler, error) {
+	var matched string
+	for name := range r.tasks {
+		if strings.Contains(functionName, name) && len(name) > len(matched) {
+			matched = name
+		}
+	}
+	if matched == "" {
+		return nil, &TaskError{Name: ErrorRuntime, Cause: fmt.Sprintf("task handler not registered: %v", functionName)}
+	}
+	return r.tasks[matched], nil
+}
+
+func catch(state State, input interface{}, taskErr *TaskError) (interface{}, string, bool) {
+	for _, catcher := range state.Catch {
+		for _, e := range catcher.ErrorEquals {
+			if e != taskErr.Name && (e != ErrorAll || taskErr.Name == ErrorRuntime) {
+				continue
+			}
+			errorOutput := map[string]interface{}{
+				"Error": taskErr.Name,
+				"Cause": taskErr.Cause,
+			}
+			if catcher.ResultPath == nil {
+				return errorOutput, catcher.Next, true
+			}
+			output, err := setPath(input, *catcher.ResultPath, errorOutput)
+			if err != nil {
+				return nil, "", false
+			}
+			return output, catcher.Next, true
+		}
+	}
+	return nil, "", false
+}
+
+func toTaskError(err error) *TaskError {
+	var taskErr *TaskError
+	if errors.As(err, &taskErr) {
+		return taskErr
+	}
+	return &TaskError{Name: ErrorTaskFailed, Cause: err.Error()}
+}
+
+func matchRetrier(retriers []Retrier, errorName string) (int, Retrier, bool) {
+	for i, retrier := range retriers {
+		for _, e := range retrier.ErrorEquals {
+			if e == errorName || (e == ErrorAll && errorName != ErrorRuntime) {
+				return i, retrier, true
+			}
+		}
+	}
+	return 0, Retrier{}, false
+}
+
+func (r Retrier) maxAttempts() int {
+	if r.MaxAttempts == nil {
+		return DefaultRetryMaxAttempts
+	}
+	return *r.MaxAttempts
+}
+
+func (r Retrier) interval(attempt int) time.Duration {
+	intervalSeconds := DefaultRetryIntervalSeconds
+	if r.IntervalSeconds != nil {
+		intervalSeconds = *r.IntervalSeconds
+	}
+	backoffRate := DefaultRetryBackoffRate
+	if r.BackoffRate != nil {
+		backoffRate = *r.BackoffRate
+	}
+	return time.Duration(float64(intervalSeconds) * math.Pow(backoffRate, float64(attempt)) * float64(time.Second))
+}
+
+func applyInputPath(state State, data interface{}) (interface{}, error) {
+	if state.InputPath == nil {
+		return data, nil
+	}
+	return getPath(data, *state.InputPath)
+}
+
+func applyOutputPath(state State, data interface{}) (interface{}, error) {
+	if state.OutputPath == nil {
+		return data, nil
+	}
+	return getPath(data, *state.OutputPath)
+}
+
+func filterInputOutput(state State, data interface{}) (interface{}, error) {
+	input, err := applyInputPath(state, data)
+	if err != nil {
+		return nil, err
+	}
+	return applyOutputPath(state, input)
+}
+
+func choose(state State, input interface{}) (string, error) {
+	for _, rule := range state.Choices {
+		matched, err := rule.match(input)
+		if err != nil {
+			return "", err
+		}
+		if matched {
+			return rule.Next, nil
+		}
+	}
+	if state.Default == "" {
+		return "", &TaskError{Name: "States.NoChoiceMatched", Cause: "no choice rule matched"}
+	}
+	return state.Default, nil
+}
+
+func (c ChoiceRule) match(input interface{}) (bool, error) {
+	switch {
+	case len(c.And) > 0:
+		for _, rule := range c.And {
+			matched, err := rule.match(input)
+			if err != nil || !matched {
+				return false, err
+			}
+		}
+		return true, nil
+	case len(c.Or) > 0:
+		for _, rule := range c.Or {
+			matched, err := rule.match(input)
+			if err != nil || matched {
+				return matched, err
+			}
+		}
+		return false, nil
+	case c.Not != nil:
+		matched, err := c.Not.match(input)
+		return !matched, err
+	}
+
+	value, err := getPath(input, c.Variable)
+	if c.IsPresent != nil {
+		return (err == nil) == *c.IsPresent, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	switch {
+	case c.StringEquals != nil:
+		s, ok := value.(string)
+		return ok && s == *c.StringEquals, nil
+	case c.BooleanEquals != nil:
+		b, ok := value.(bool)
+		return ok && b == *c.BooleanEquals, nil
+	}
+	n, ok := value.(float64)
+	if !ok {
+		return false, nil
+	}
+	switch {
+	case c.NumericEquals != nil:
+		return n == *c.NumericEquals, nil
+	case c.NumericLessThan != nil:
+		return n < *c.NumericLessThan, nil
+	case c.NumericLessThanEquals != nil:
+		return n <= *c.NumericLessThanEquals, nil
+	case c.NumericGreaterThan != nil:
+		return n > *c.NumericGreaterThan, nil
+	case c.NumericGreaterThanEquals != nil:
+		return n >= *c.NumericGreaterThanEquals, nil
+	}
+	return false, fmt.Errorf("unsupported choice rule: variable: %v", c.Variable)
+}
+
+func sleep(ctx context.Context, d time.Duration) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-time.After(d):
+		return nil
+	}
+}
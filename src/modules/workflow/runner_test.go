@@ -0,0 +1,241 @@
+package workflow
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"os"
+	"testing"
+	"time"
+)
+
+const (
+	UpdatePatientDetailsDefinition = "../../../step_functions/update_patient_details.json"
+)
+
+type stubTasks struct {
+	uploadStatus  int
+	updateErrors  []error
+	updateInputs  []map[string]interface{}
+	notifyInputs  []map[string]interface{}
+	notifyErr     error
+	alerts        []map[string]interface{}
+	sleepDuration []time.Duration
+}
+
+func (s *stubTasks) runner() *Runner {
+	r := NewRunner()
+	r.Sleep = func(ctx context.Context, d time.Duration) error {
+		s.sleepDuration = append(s.sleepDuration, d)
+		return nil
+	}
+	r.Register("UploadPatientDetailsFileToS3Function", func(ctx context.Context, payload []byte) ([]byte, error) {
+		return json.Marshal(map[string]interface{}{"Status": s.uploadStatus, "ObjectKey": "20230101221819"})
+	})
+	r.Register("UpdatePatientDetailsTableFunction", func(ctx context.Context, payload []byte) ([]byte, error) {
+		var input map[string]interface{}
+		if err := json.Unmarshal(payload, &input); err != nil {
+			return nil, err
+		}
+		s.updateInputs = append(s.updateInputs, input)
+		if len(s.updateErrors) > 0 {
+			err := s.updateErrors[0]
+			s.updateErrors = s.updateErrors[1:]
+			return nil, err
+		}
+		return []byte(`{"Status":1}`), nil
+	})
+	r.Register("EvaluateAlertRulesFunction", func(ctx context.Context, payload []byte) ([]byte, error) {
+		return json.Marshal(map[string]interface{}{"Count": len(s.alerts), "Alerts": s.alerts})
+	})
+	r.Register("NotifyExecutionOfPatientDetailsScheduleFunction", func(ctx context.Context, payload []byte) ([]byte, error) {
+		var input map[string]interface{}
+		if err := json.Unmarshal(payload, &input); err != nil {
+			return nil, err
+		}
+		s.notifyInputs = append(s.notifyInputs, input)
+		if s.notifyErr != nil {
+			return nil, s.notifyErr
+		}
+		return []byte("null"), nil
+	})
+	return r
+}
+
+func loadUpdatePatientDetails(t *testing.T) *StateMachine {
+	data, err := os.ReadFile(UpdatePatientDetailsDefinition)
+	assert.NoError(t, err)
+	sm, err := Parse(data)
+	assert.NoError(t, err)
+	return sm
+}
+
+func TestRun_Success(t *testing.T) {
+	sm := loadUpdatePatientDetails(t)
+	tasks := &stubTasks{uploadStatus: 1}
+
+	execution, err := tasks.runner().Run(context.Background(), sm, "test", []byte("{}"))
+	assert.NoError(t, err)
+	assert.Equal(t, ExecutionStatusSucceeded, execution.Status)
+	assert.Equal(t, []string{
+		"Upload patient details file to s3",
+		"Upload completed",
+		"Update patient details table",
+		"Update completed",
+		"Evaluate alert rules",
+		"Alerts triggered",
+		"Notify update success",
+		"Success",
+	}, execution.History)
+	assert.Equal(t, "20230101221819", tasks.updateInputs[0]["ObjectKey"])
+	assert.Len(t, tasks.notifyInputs, 1)
+	assert.Equal(t, "digest", tasks.notifyInputs[0]["Mode"])
+	assert.Equal(t, float64(1), tasks.notifyInputs[0]["Input"].(map[string]interface{})["Status"])
+}
+
+func TestRun_AlertsTriggered(t *testing.T) {
+	sm := loadUpdatePatientDetails(t)
+	alert := map[string]interface{}{"RuleId": float64(1), "Area": "東京都"}
+	tasks := &stubTasks{uploadStatus: 1, alerts: []map[string]interface{}{alert}}
+
+	execution, err := tasks.runner().Run(context.Background(), sm, "test", []byte("{}"))
+	assert.NoError(t, err)
+	assert.Equal(t, ExecutionStatusSucceeded, execution.Status)
+	assert.Len(t, tasks.notifyInputs, 2)
+	assert.Equal(t, map[string]interface{}{
+		"Mode":        "alert",
+		"ExecutionId": "test",
+		"Input":       map[string]interface{}{"Count": float64(1), "Alerts": []interface{}{alert}},
+	}, tasks.notifyInputs[0])
+	assert.Equal(t, "digest", tasks.notifyInputs[1]["Mode"])
+}
+
+func TestRun_DigestNotifyFailure(t *testing.T) {
+	sm := loadUpdatePatientDetails(t)
+	tasks := &stubTasks{uploadStatus: 1, notifyErr: errors.New("webhook error")}
+
+	tasks.alerts = []map[string]interface{}{{"RuleId": float64(1), "Area": "東京都"}}
+
+	// アラートや日次のまとめの通知に失敗しても定期実行は成功
+	execution, err := tasks.runner().Run(context.Background(), sm, "test", []byte("{}"))
+	assert.NoError(t, err)
+	assert.Equal(t, ExecutionStatusSucceeded, execution.Status)
+	assert.Len(t, tasks.notifyInputs, 2)
+}
+
+func TestRun_UploadFailure(t *testing.T) {
+	sm := loadUpdatePatientDetails(t)
+	tasks := &stubTasks{uploadStatus: 0}
+
+	execution, err := tasks.runner().Run(context.Background(), sm, "test", []byte("{}"))
+	assert.NoError(t, err)
+	assert.Equal(t, ExecutionStatusFailed, execution.Status)
+	assert.Equal(t, "Upload failure", execution.History[len(execution.History)-1])
+	assert.Len(t, tasks.notifyInputs, 1)
+	assert.Empty(t, tasks.updateInputs)
+}
+
+func TestRun_RetryLambdaServiceException(t *testing.T) {
+	sm := loadUpdatePatientDetails(t)
+	tasks := &stubTasks{
+		uploadStatus: 1,
+		updateErrors: []error{&TaskError{Name: "Lambda.ServiceException", Cause: "temporary"}},
+	}
+
+	execution, err := tasks.runner().Run(context.Background(), sm, "test", []byte("{}"))
+	assert.NoError(t, err)
+	assert.Equal(t, ExecutionStatusSucceeded, execution.Status)
+	assert.Len(t, tasks.updateInputs, 2)
+	assert.Equal(t, []time.Duration{2 * time.Second}, tasks.sleepDuration)
+}
+
+func TestRun_RetryExhausted(t *testing.T) {
+	sm := loadUpdatePatientDetails(t)
+	tasks := &stubTasks{
+		uploadStatus: 1,
+		updateErrors: []error{
+			&TaskError{Name: "Lambda.ServiceException", Cause: "temporary"},
+			&TaskError{Name: "Lambda.ServiceException", Cause: "temporary"},
+		},
+	}
+
+	execution, err := tasks.runner().Run(context.Background(), sm, "test", []byte("{}"))
+	assert.NoError(t, err)
+	assert.Equal(t, ExecutionStatusFailed, execution.Status)
+	assert.Equal(t, "Update failure", execution.History[len(execution.History)-1])
+	assert.Len(t, tasks.updateInputs, 2)
+
+	// Catch したエラー情報が通知に渡る
+	assert.Len(t, tasks.notifyInputs, 1)
+	assert.Equal(t, "Update patient details table", tasks.notifyInputs[0]["FailedState"])
+	assert.Equal(t, "test", tasks.notifyInputs[0]["ExecutionId"])
+	assert.Equal(t, map[string]interface{}{
+		"Status":    float64(1),
+		"ObjectKey": "20230101221819",
+		"ErrorInfo": map[string]interface{}{"Error": "Lambda.ServiceException", "Cause": "temporary"},
+	}, tasks.notifyInputs[0]["Input"])
+}
+
+func TestRun_CatchTaskFailed(t *testing.T) {
+	sm := loadUpdatePatientDetails(t)
+	tasks := &stubTasks{
+		uploadStatus: 1,
+		updateErrors: []error{errors.New("db error")},
+	}
+
+	execution, err := tasks.runner().Run(context.Background(), sm, "test", []byte("{}"))
+	assert.NoError(t, err)
+	assert.Equal(t, ExecutionStatusFailed, execution.Status)
+	assert.Len(t, tasks.updateInputs, 1)
+	assert.Len(t, tasks.notifyInputs, 1)
+	input := tasks.notifyInputs[0]["Input"].(map[string]interface{})
+	assert.Equal(t, map[string]interface{}{"Error": ErrorTaskFailed, "Cause": "db error"}, input["ErrorInfo"])
+}
+
+func TestRun_TaskHandlerNotRegistered(t *testing.T) {
+	sm := loadUpdatePatientDetails(t)
+
+	execution, err := NewRunner().Run(context.Background(), sm, "test", []byte("{}"))
+	assert.NoError(t, err)
+	assert.Equal(t, ExecutionStatusFailed, execution.Status)
+	assert.Equal(t, ErrorRuntime, execution.Error)
+}
+
+func TestParse_InvalidNext(t *testing.T) {
+	_, err := Parse([]byte(`{"StartAt":"A","States":{"A":{"Type":"Task","Resource":"x","Next":"B"}}}`))
+	assert.Error(t, err)
+}
+
+func TestResolveParameters(t *testing.T) {
+	input := map[string]interface{}{"ObjectKey": "key", "Status": float64(1)}
+	contextObject := map[string]interface{}{"Execution": map[string]interface{}{"Id": "exec-1"}}
+	parameters := map[string]interface{}{
+		"FunctionName": "fn",
+		"Payload": map[string]interface{}{
+			"ObjectKey.$":   "$.ObjectKey",
+			"ExecutionId.$": "$$.Execution.Id",
+		},
+	}
+
+	got, err := resolveParameters(parameters, input, contextObject)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{
+		"FunctionName": "fn",
+		"Payload": map[string]interface{}{
+			"ObjectKey":   "key",
+			"ExecutionId": "exec-1",
+		},
+	}, got)
+}
+
+func TestSetPath(t *testing.T) {
+	input := map[string]interface{}{"ObjectKey": "key"}
+	got, err := setPath(input, "$.Error.Cause", "boom")
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{
+		"ObjectKey": "key",
+		"Error":     map[string]interface{}{"Cause": "boom"},
+	}, got)
+	assert.Equal(t, map[string]interface{}{"ObjectKey": "key"}, input)
+}
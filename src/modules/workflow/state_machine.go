@@ -0,0 +1,123 @@
+package workflow
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// Amazon States Language のうち定期実行で使っている部分の定義
+const (
+	StateTypeTask    = "Task"
+	StateTypeChoice  = "Choice"
+	StateTypePass    = "Pass"
+	StateTypeSucceed = "Succeed"
+	StateTypeFail    = "Fail"
+)
+
+const (
+	ResourceLambdaInvoke = "arn:aws:states:::lambda:invoke"
+)
+
+type StateMachine struct {
+	Comment string           `json:"Comment"`
+	StartAt string           `json:"StartAt"`
+	States  map[string]State `json:"States"`
+}
+
+type State struct {
+	Type       string                 `json:"Type"`
+	Comment    string                 `json:"Comment"`
+	Resource   string                 `json:"Resource"`
+	Parameters map[string]interface{} `json:"Parameters"`
+	InputPath  *string                `json:"InputPath"`
+	OutputPath *string                `json:"OutputPath"`
+	ResultPath *string                `json:"ResultPath"`
+	Retry      []Retrier              `json:"Retry"`
+	Catch      []Catcher              `json:"Catch"`
+	Choices    []ChoiceRule           `json:"Choices"`
+	Default    string                 `json:"Default"`
+	Next       string                 `json:"Next"`
+	End        bool                   `json:"End"`
+	Error      string                 `json:"Error"`
+	Cause      string                 `json:"Cause"`
+}
+
+type Retrier struct {
+	ErrorEquals     []string `json:"ErrorEquals"`
+	IntervalSeconds *int     `json:"IntervalSeconds"`
+	MaxAttempts     *int     `json:"MaxAttempts"`
+	BackoffRate     *float64 `json:"BackoffRate"`
+}
+
+type Catcher struct {
+	ErrorEquals []string `json:"ErrorEquals"`
+	ResultPath  *string  `json:"ResultPath"`
+	Next        string   `json:"Next"`
+}
+
+type ChoiceRule struct {
+	Variable                 string       `json:"Variable"`
+	StringEquals             *string      `json:"StringEquals"`
+	NumericEquals            *float64     `json:"NumericEquals"`
+	NumericLessThan          *float64     `json:"NumericLessThan"`
+	NumericLessThanEquals    *float64     `json:"NumericLessThanEquals"`
+	NumericGreaterThan       *float64     `json:"NumericGreaterThan"`
+	NumericGreaterThanEquals *float64     `json:"NumericGreaterThanEquals"`
+	BooleanEquals            *bool        `json:"BooleanEquals"`
+	IsPresent                *bool        `json:"IsPresent"`
+	And                      []ChoiceRule `json:"And"`
+	Or                       []ChoiceRule `json:"Or"`
+	Not                      *ChoiceRule  `json:"Not"`
+	Next                     string       `json:"Next"`
+}
+
+func Parse(data []byte) (*StateMachine, error) {
+	var sm StateMachine
+	if err := json.Unmarshal(data, &sm); err != nil {
+		return nil, fmt.Errorf("json.Unmarshal() error: %v", err)
+	}
+	if err := sm.validate(); err != nil {
+		return nil, err
+	}
+	return &sm, nil
+}
+
+func (sm *StateMachine) validate() error {
+	if _, ok := sm.States[sm.StartAt]; !ok {
+		return fmt.Errorf("StartAt state not found: %v", sm.StartAt)
+	}
+	for name, state := range sm.States {
+		var nexts []string
+		switch state.Type {
+		case StateTypeTask, StateTypePass:
+			if state.Type == StateTypeTask && state.Resource == "" {
+				return fmt.Errorf("state %v: Resource is required", name)
+			}
+			if !state.End {
+				nexts = append(nexts, state.Next)
+			}
+			for _, catcher := range state.Catch {
+				nexts = append(nexts, catcher.Next)
+			}
+		case StateTypeChoice:
+			if len(state.Choices) == 0 {
+				return fmt.Errorf("state %v: Choices is required", name)
+			}
+			for _, choice := range state.Choices {
+				nexts = append(nexts, choice.Next)
+			}
+			if state.Default != "" {
+				nexts = append(nexts, state.Default)
+			}
+		case StateTypeSucceed, StateTypeFail:
+		default:
+			return fmt.Errorf("state %v: unsupported state type: %v", name, state.Type)
+		}
+		for _, next := range nexts {
+			if _, ok := sm.States[next]; !ok {
+				return fmt.Errorf("state %v: next state not found: %q", name, next)
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,195 @@
+package service
+
+import (
+	"context"
+	"corona-api/src/middleware"
+	"corona-api/src/modules/alert"
+	"corona-api/src/modules/common"
+	"corona-api/src/modules/notification"
+	"corona-api/src/modules/subscription"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strconv"
+	"unicode/utf8"
+)
+
+const (
+	AlertSubscriptionAreaMaxLength = 16
+	AlertDeliveryLogLimit          = 100
+)
+
+// CreateAlertSubscriptionRequest は購読登録のリクエスト
+type CreateAlertSubscriptionRequest struct {
+	Area       string   `json:"area"`
+	Metric     string   `json:"metric"`
+	Threshold  *float64 `json:"threshold"`
+	WebhookURL string   `json:"webhook_url"`
+}
+
+// CreateAlertSubscriptionResponse は購読登録のレスポンス
+// 署名鍵は登録時だけ返す
+type CreateAlertSubscriptionResponse struct {
+	subscription.Subscription
+	Secret string `json:"secret"`
+}
+
+type AlertSubscriptionsResponse struct {
+	Subscriptions []subscription.Subscription `json:"subscriptions"`
+}
+
+type AlertDeliveriesResponse struct {
+	Deliveries []subscription.Delivery `json:"deliveries"`
+}
+
+type AlertSubscriptionService struct {
+	connectDb func() (*sql.DB, error)
+}
+
+func NewAlertSubscriptionService(connectDb func() (*sql.DB, error)) *AlertSubscriptionService {
+	return &AlertSubscriptionService{connectDb: connectDb}
+}
+
+func (s *AlertSubscriptionService) CreateSubscription(ctx context.Context, request CreateAlertSubscriptionRequest) (CreateAlertSubscriptionResponse, error) {
+	// パラメーターの検証
+	if err := ValidateCreateAlertSubscriptionRequest(ctx, request); err != nil {
+		return CreateAlertSubscriptionResponse{}, err
+	}
+	secret, err := subscription.NewSecret()
+	if err != nil {
+		return CreateAlertSubscriptionResponse{}, common.NewInternalError(err)
+	}
+
+	// DB接続
+	db, err := s.connectDb()
+	if err != nil {
+		return CreateAlertSubscriptionResponse{}, common.NewUpstreamUnavailableError(err)
+	}
+	defer db.Close()
+
+	sub := subscription.Subscription{
+		Area:       request.Area,
+		Metric:     request.Metric,
+		Threshold:  *request.Threshold,
+		WebhookURL: request.WebhookURL,
+		Secret:     secret,
+	}
+	if err := subscription.CreateSubscription(ctx, db, &sub); err != nil {
+		return CreateAlertSubscriptionResponse{}, common.NewInternalError(err)
+	}
+	return CreateAlertSubscriptionResponse{Subscription: sub, Secret: secret}, nil
+}
+
+func (s *AlertSubscriptionService) ListSubscriptions(ctx context.Context, area string) (AlertSubscriptionsResponse, error) {
+	// DB接続
+	db, err := s.connectDb()
+	if err != nil {
+		return AlertSubscriptionsResponse{}, common.NewUpstreamUnavailableError(err)
+	}
+	defer db.Close()
+
+	subscriptions, err := subscription.GetSubscriptions(ctx, db, area)
+	if err != nil {
+		return AlertSubscriptionsResponse{}, common.NewInternalError(err)
+	}
+	return AlertSubscriptionsResponse{Subscriptions: subscriptions}, nil
+}
+
+func (s *AlertSubscriptionService) DeleteSubscription(ctx context.Context, id string) error {
+	subscriptionID, err := parseID(id)
+	if err != nil {
+		return err
+	}
+
+	// DB接続
+	db, err := s.connectDb()
+	if err != nil {
+		return common.NewUpstreamUnavailableError(err)
+	}
+	defer db.Close()
+
+	err = subscription.DeleteSubscription(ctx, middleware.NewTxAdmin(db), subscriptionID)
+	if errors.Is(err, subscription.ErrSubscriptionNotFound) {
+		return common.NewNotFoundError(fmt.Errorf("alert subscription not found: id: %v", subscriptionID))
+	}
+	if err != nil {
+		return common.NewInternalError(err)
+	}
+	return nil
+}
+
+func (s *AlertSubscriptionService) ListDeliveries(ctx context.Context, id string) (AlertDeliveriesResponse, error) {
+	subscriptionID, err := parseID(id)
+	if err != nil {
+		return AlertDeliveriesResponse{}, err
+	}
+
+	// DB接続
+	db, err := s.connectDb()
+	if err != nil {
+		return AlertDeliveriesResponse{}, common.NewUpstreamUnavailableError(err)
+	}
+	defer db.Close()
+
+	deliveries, err := subscription.GetDeliveries(ctx, db, subscriptionID, AlertDeliveryLogLimit)
+	if err != nil {
+		return AlertDeliveriesResponse{}, common.NewInternalError(err)
+	}
+	return AlertDeliveriesResponse{Deliveries: deliveries}, nil
+}
+
+func ValidateCreateAlertSubscriptionRequest(ctx context.Context, request CreateAlertSubscriptionRequest) error {
+	var fieldErrors []common.FieldError
+	if request.Area == "" {
+		fieldErrors = append(fieldErrors, common.FieldError{Field: "area", Reason: common.FieldReasonRequired})
+	} else if utf8.RuneCountInString(request.Area) > AlertSubscriptionAreaMaxLength {
+		fieldErrors = append(fieldErrors, common.FieldError{Field: "area", Reason: common.FieldReasonOutOfRange})
+	}
+
+	switch request.Metric {
+	case "":
+		fieldErrors = append(fieldErrors, common.FieldError{Field: "metric", Reason: common.FieldReasonRequired})
+	case alert.RuleKindWeekOverWeek, alert.RuleKindLevel:
+	default:
+		fieldErrors = append(fieldErrors, common.FieldError{Field: "metric", Reason: common.FieldReasonInvalidFormat})
+	}
+
+	if request.Threshold == nil {
+		fieldErrors = append(fieldErrors, common.FieldError{Field: "threshold", Reason: common.FieldReasonRequired})
+	} else if request.Metric == alert.RuleKindLevel && *request.Threshold < 0 {
+		fieldErrors = append(fieldErrors, common.FieldError{Field: "threshold", Reason: common.FieldReasonOutOfRange})
+	}
+
+	if fieldError := validateWebhookURL(ctx, "webhook_url", request.WebhookURL); fieldError != nil {
+		fieldErrors = append(fieldErrors, *fieldError)
+	}
+
+	if len(fieldErrors) > 0 {
+		return common.NewValidationError(fmt.Errorf("invalid alert subscription: area: %v, metric: %v", request.Area, request.Metric), fieldErrors...)
+	}
+	return nil
+}
+
+// validateWebhookURL は利用者が登録する Webhook のURLが https で、内部のネットワークを指していないか検証する
+func validateWebhookURL(ctx context.Context, field string, value string) *common.FieldError {
+	if value == "" {
+		return &common.FieldError{Field: field, Reason: common.FieldReasonRequired}
+	}
+	err := notification.ValidateWebhookURL(ctx, value)
+	if errors.Is(err, notification.ErrForbiddenAddress) {
+		return &common.FieldError{Field: field, Reason: common.FieldReasonNotAllowed}
+	}
+	if err != nil {
+		return &common.FieldError{Field: field, Reason: common.FieldReasonInvalidFormat}
+	}
+	return nil
+}
+
+// parseID はパスパラメータのIDを検証する
+func parseID(id string) (int64, error) {
+	parsed, err := strconv.ParseInt(id, 10, 64)
+	if err != nil || parsed <= 0 {
+		return 0, common.NewValidationError(fmt.Errorf("invalid id: %v", id), common.FieldError{Field: "id", Reason: common.FieldReasonInvalidFormat})
+	}
+	return parsed, nil
+}
@@ -0,0 +1,200 @@
+package service
+
+import (
+	"context"
+	"corona-api/src/modules/analytics"
+	"corona-api/src/modules/common"
+	"corona-api/src/modules/date"
+	"corona-api/src/modules/patient"
+	"database/sql"
+	"errors"
+	"fmt"
+)
+
+const (
+	// ForecastHorizonMaxDays は予測できる日数の上限
+	ForecastHorizonMaxDays = 14
+	// ForecastWindowMaxDays は学習に使う日数の上限
+	ForecastWindowMaxDays = 365
+	// BacktestMaxWindows は検証する予測の起点の数の上限(1年分)
+	BacktestMaxWindows = 52
+)
+
+// ForecastRequest は予測のリクエスト(未検証の値)
+// EndDate は学習に使う最後の日(未指定なら最新のデータの日付)で、その翌日から予測する。その日のデータが無ければ予測しない
+type ForecastRequest struct {
+	Area     string
+	EndDate  string
+	Model    string
+	Horizon  string
+	Window   string
+	Level    string
+	Backtest string
+}
+
+// ForecastQuery は形式を検証した予測の条件(相対指定は未解決)
+type ForecastQuery struct {
+	Area    string
+	End     date.Expr
+	Options analytics.ForecastOptions
+	// Backtest は検証する予測の起点の数(0 なら検証しない)
+	Backtest int
+}
+
+// ForecastResponse は予測のレスポンス
+type ForecastResponse struct {
+	Area           string                    `json:"area"`
+	TrainingPeriod date.Range                `json:"training_period"`
+	Options        analytics.ForecastOptions `json:"options"`
+	Forecasts      []analytics.ForecastPoint `json:"forecasts"`
+	Backtest       *analytics.BacktestResult `json:"backtest,omitempty"`
+}
+
+type ForecastService struct {
+	connectDb func() (*sql.DB, error)
+	clock     date.Clock
+}
+
+func NewForecastService(connectDb func() (*sql.DB, error), clock date.Clock) *ForecastService {
+	return &ForecastService{connectDb: connectDb, clock: clock}
+}
+
+func (s *ForecastService) GetForecast(ctx context.Context, request ForecastRequest) (ForecastResponse, error) {
+	// パラメーターの形式の検証
+	query, err := ParseForecastRequest(request)
+	if err != nil {
+		return ForecastResponse{}, err
+	}
+	today := date.Today(s.clock)
+
+	// 相対指定が無ければDBに接続する前に日付を検証する
+	if !query.End.Relative() {
+		if err := validateForecastEnd(query.End.Resolve(date.Date{}), today); err != nil {
+			return ForecastResponse{}, err
+		}
+	}
+
+	// DB接続
+	db, err := s.connectDb()
+	if err != nil {
+		return ForecastResponse{}, common.NewUpstreamUnavailableError(err)
+	}
+	defer db.Close()
+
+	end := query.End.Resolve(date.Date{})
+	if query.End.Relative() {
+		latest, err := patient.GetLatestDateOfArea(ctx, db, query.Area)
+		if errors.Is(err, patient.ErrNoPatientDetails) {
+			return ForecastResponse{}, common.NewNotFoundError(fmt.Errorf("patient details not found: area: %v", query.Area))
+		}
+		if err != nil {
+			return ForecastResponse{}, common.NewInternalError(err)
+		}
+		end = query.End.Resolve(latest)
+		if err := validateForecastEnd(end, today); err != nil {
+			return ForecastResponse{}, err
+		}
+	}
+
+	// 学習に使う期間と、検証する起点の分だけ前から取得する
+	opts := query.Options
+	days := opts.Window
+	if query.Backtest > 0 {
+		days += opts.Horizon + (query.Backtest-1)*analytics.BacktestWindowStep
+	}
+	patientDetails, err := patient.GetPatientDetailsByPeriodAndArea(db, query.Area, date.Range{Start: end.AddDays(-(days - 1)), End: end})
+	if err != nil {
+		return ForecastResponse{}, common.NewInternalError(err)
+	}
+	// end のデータが無いと指定より前の日から予測することになるので予測しない
+	series := analytics.NewSeries(patientDetails)
+	if series.Len() == 0 || series.Date(series.Len()-1) != end {
+		return ForecastResponse{}, common.NewNotFoundError(fmt.Errorf("patient details not found: area: %v, date: %v", query.Area, end))
+	}
+	// 途中でデータが無い日は0として扱うので、系列の長さは end までの暦日の日数
+	if series.Len() < opts.Window {
+		return ForecastResponse{}, common.NewNotFoundError(fmt.Errorf("not enough patient details: area: %v, days: %v, window: %v", query.Area, series.Len(), opts.Window))
+	}
+
+	// 指定した起点の数だけ遡れるデータが無い場合は指定の誤りにする
+	if query.Backtest > 0 && series.Len() < days {
+		return ForecastResponse{}, common.NewValidationError(fmt.Errorf("not enough patient details for backtest: area: %v, days: %v, required: %v", query.Area, series.Len(), days),
+			common.FieldError{Field: "backtest", Reason: common.FieldReasonOutOfRange})
+	}
+
+	forecasts, err := analytics.Forecast(series, opts)
+	if err != nil {
+		return ForecastResponse{}, common.NewInternalError(err)
+	}
+	res := ForecastResponse{
+		Area:           query.Area,
+		TrainingPeriod: date.Range{Start: series.Date(series.Len() - opts.Window), End: series.Date(series.Len() - 1)},
+		Options:        opts,
+		Forecasts:      forecasts,
+	}
+	if query.Backtest > 0 {
+		backtest, err := analytics.Backtest(series, opts, query.Backtest)
+		if err != nil {
+			return ForecastResponse{}, common.NewInternalError(err)
+		}
+		res.Backtest = &backtest
+	}
+	return res, nil
+}
+
+// ParseForecastRequest はパラメーターの形式を検証する。予測の設定は指定が無ければ既定値にする
+func ParseForecastRequest(request ForecastRequest) (ForecastQuery, error) {
+	query := ForecastQuery{Area: request.Area, Options: analytics.DefaultForecastOptions()}
+
+	var fieldErrors []common.FieldError
+	if request.Area == "" {
+		fieldErrors = append(fieldErrors, common.FieldError{Field: "area", Reason: common.FieldReasonRequired})
+	}
+	end := request.EndDate
+	if end == "" {
+		end = "latest"
+	}
+	var fieldError *common.FieldError
+	query.End, fieldError = parseDateParam("end_date", end)
+	if fieldError != nil {
+		fieldErrors = append(fieldErrors, *fieldError)
+	}
+	if request.Model != "" {
+		query.Options.Model = request.Model
+		valid := false
+		for _, model := range analytics.Models {
+			if request.Model == model {
+				valid = true
+			}
+		}
+		if !valid {
+			fieldErrors = append(fieldErrors, common.FieldError{Field: "model", Reason: common.FieldReasonInvalidFormat})
+		}
+	}
+	for _, fieldError := range []*common.FieldError{
+		parseIntParam("horizon", request.Horizon, &query.Options.Horizon, func(v int) bool { return v >= 1 && v <= ForecastHorizonMaxDays }),
+		parseIntParam("window", request.Window, &query.Options.Window, func(v int) bool {
+			return v >= analytics.MinForecastWindow && v <= ForecastWindowMaxDays
+		}),
+		parseFloatParam("level", request.Level, &query.Options.Level, func(v float64) bool { return v > 0 && v < 1 }),
+		parseIntParam("backtest", request.Backtest, &query.Backtest, func(v int) bool { return v >= 0 && v <= BacktestMaxWindows }),
+	} {
+		if fieldError != nil {
+			fieldErrors = append(fieldErrors, *fieldError)
+		}
+	}
+
+	if len(fieldErrors) > 0 {
+		return ForecastQuery{}, common.NewValidationError(fmt.Errorf("invalid parameter: %+v", request), fieldErrors...)
+	}
+	return query, nil
+}
+
+// validateForecastEnd は学習に使う最後の日が集計の開始日から today の前日までか検証する
+func validateForecastEnd(end date.Date, today date.Date) error {
+	available := date.Range{Start: StartDateOfCountingPatientDetails, End: today.AddDays(-1)}
+	if !available.Contains(end) {
+		return common.NewValidationError(fmt.Errorf("invalid end date: %v", end), common.FieldError{Field: "end_date", Reason: common.FieldReasonOutOfRange})
+	}
+	return nil
+}
@@ -0,0 +1,157 @@
+//go:build cgo
+// +build cgo
+
+package service
+
+import (
+	"context"
+	"corona-api/src/middleware"
+	"corona-api/src/modules/analytics"
+	"corona-api/src/modules/common"
+	"corona-api/src/modules/date"
+	"corona-api/src/modules/migration"
+	"database/sql"
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"math"
+	"net/http"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+)
+
+// newSQLiteConnectDb はマイグレーションを適用した SQLite に patient_details を登録し、接続する関数を返す
+// サービスは接続を閉じるので、呼び出しごとに開き直す
+func newSQLiteConnectDb(t *testing.T, area string, values map[date.Date]int) func() (*sql.DB, error) {
+	path := filepath.Join(t.TempDir(), "corona.db")
+	connectDb := func() (*sql.DB, error) {
+		return sql.Open(middleware.DriverSQLite, path)
+	}
+	db, err := connectDb()
+	assert.NoError(t, err)
+	defer db.Close()
+	migrations, err := migration.Load(filepath.Join("..", "..", migration.SQLiteDir))
+	assert.NoError(t, err)
+	_, err = migration.Apply(context.Background(), db, migrations)
+	assert.NoError(t, err)
+	for d, v := range values {
+		_, err := db.Exec("INSERT INTO patient_details (date, area, value, country) VALUES (?,?,?,?)", d, area, v, "Japan")
+		assert.NoError(t, err)
+	}
+	return connectDb
+}
+
+// weeklyValues は start から days 日間、週ごとに増えて月曜が半分になる感染者数
+func weeklyValues(start date.Date, days int) map[date.Date]int {
+	values := map[date.Date]int{}
+	for i := 0; i < days; i++ {
+		d := start.AddDays(i)
+		v := 1000 * math.Exp(0.01*float64(i))
+		if d.Weekday() == time.Monday {
+			v /= 2
+		}
+		values[d] = int(v)
+	}
+	return values
+}
+
+// without は指定した日のデータを除く
+func without(values map[date.Date]int, dates ...date.Date) map[date.Date]int {
+	res := map[date.Date]int{}
+	for d, v := range values {
+		res[d] = v
+	}
+	for _, d := range dates {
+		delete(res, d)
+	}
+	return res
+}
+
+func TestForecastService_GetForecast_SQLite(t *testing.T) {
+	const area = "東京都"
+	start := date.MustParse("2022-06-01")
+	end := date.MustParse("2022-09-30")
+	clock := date.FixedClock{Time: time.Date(2022, 10, 15, 0, 0, 0, 0, time.UTC)}
+
+	tests := []struct {
+		name    string
+		values  map[date.Date]int
+		request ForecastRequest
+		// wantStatus はエラーの場合のHTTPステータス、wantField は検証エラーの項目
+		wantStatus int
+		wantField  string
+		check      func(t *testing.T, res ForecastResponse)
+	}{
+		{
+			name:    "backtest",
+			values:  weeklyValues(start, end.Sub(start)+1),
+			request: ForecastRequest{Area: area, EndDate: end.String(), Backtest: "4"},
+			check: func(t *testing.T, res ForecastResponse) {
+				assert.Equal(t, date.Range{Start: end.AddDays(-(analytics.DefaultForecastWindow - 1)), End: end}, res.TrainingPeriod)
+				assert.Equal(t, end.AddDays(1), res.Forecasts[0].Date)
+				assert.Equal(t, 4, res.Backtest.Windows)
+			},
+		},
+		{
+			// 最後の3日間が欠けていても、それより前の日から予測しない(検証のために window より長く取得する場合も)
+			name:       "missing end date",
+			values:     without(weeklyValues(start, end.Sub(start)+1), end, end.AddDays(-1), end.AddDays(-2)),
+			request:    ForecastRequest{Area: area, EndDate: end.String(), Backtest: "2"},
+			wantStatus: http.StatusNotFound,
+		},
+		{
+			// 途中の欠けている日は0として学習し、学習期間は end までの暦日
+			name:    "missing days in window",
+			values:  without(weeklyValues(start, end.Sub(start)+1), end.AddDays(-10), end.AddDays(-11)),
+			request: ForecastRequest{Area: area, EndDate: end.String(), Window: "28"},
+			check: func(t *testing.T, res ForecastResponse) {
+				assert.Equal(t, date.Range{Start: end.AddDays(-27), End: end}, res.TrainingPeriod)
+				assert.Equal(t, end.AddDays(1), res.Forecasts[0].Date)
+				assert.Len(t, res.Forecasts, analytics.DefaultForecastHorizon)
+			},
+		},
+		{
+			// 30日分の行しか無い(途中の欠けている日を含めても end までの暦日は31日)
+			name:       "not enough days for window",
+			values:     without(weeklyValues(end.AddDays(-30), 31), end.AddDays(-5)),
+			request:    ForecastRequest{Area: area, EndDate: end.String()},
+			wantStatus: http.StatusNotFound,
+		},
+		{
+			name:    "window in calendar days",
+			values:  without(weeklyValues(end.AddDays(-30), 31), end.AddDays(-5)),
+			request: ForecastRequest{Area: area, EndDate: end.String(), Window: "31"},
+			check: func(t *testing.T, res ForecastResponse) {
+				assert.Equal(t, date.Range{Start: end.AddDays(-30), End: end}, res.TrainingPeriod)
+			},
+		},
+		{
+			// 42 + 14 + 7×51 日は遡れない
+			name:       "not enough history for backtest",
+			values:     weeklyValues(start, end.Sub(start)+1),
+			request:    ForecastRequest{Area: area, EndDate: end.String(), Backtest: strconv.Itoa(BacktestMaxWindows)},
+			wantStatus: http.StatusBadRequest,
+			wantField:  "backtest",
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			s := NewForecastService(newSQLiteConnectDb(t, area, tt.values), clock)
+			res, err := s.GetForecast(context.Background(), tt.request)
+			if tt.wantStatus == 0 {
+				assert.NoError(t, err)
+				tt.check(t, res)
+				return
+			}
+			status, _ := common.ErrorStatus(err)
+			assert.Equal(t, tt.wantStatus, status, err)
+			var validationErr *common.ValidationError
+			if tt.wantField != "" && assert.True(t, errors.As(err, &validationErr), err) {
+				assert.Equal(t, tt.wantField, validationErr.Fields[0].Field)
+			}
+		})
+	}
+}
@@ -0,0 +1,242 @@
+package service
+
+import (
+	"context"
+	"corona-api/src/modules/common"
+	"corona-api/src/modules/date"
+	"corona-api/src/modules/ingestion"
+	"corona-api/src/modules/patient"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+)
+
+// StartDateOfCountingPatientDetails は集計の開始日
+var StartDateOfCountingPatientDetails = date.New(2020, time.May, 9)
+
+// PatientDetailsRequest は感染者数詳細取得のリクエスト(未検証の値)
+// 日付は latest や -30d のような最新のデータの日付からの相対指定もできる。Period は StartDate, EndDate の代わりに使う
+type PatientDetailsRequest struct {
+	Area      string
+	StartDate string
+	EndDate   string
+	Period    string
+	// Metrics はカンマ区切りの派生指標(day_over_day, week_over_week など)
+	Metrics string
+}
+
+// PatientDetailsQuery は形式を検証した検索条件(相対指定は未解決)
+type PatientDetailsQuery struct {
+	Area    string
+	Start   date.Expr
+	End     date.Expr
+	Period  *date.Period
+	Metrics []patient.Metric
+}
+
+// PatientDetailParams は検証済みの検索条件
+type PatientDetailParams struct {
+	Area    string
+	Period  date.Range
+	Metrics []patient.Metric
+}
+
+// PatientDetailsResponse は感染者数詳細取得のレスポンス
+type PatientDetailsResponse struct {
+	Details      []patient.Detail
+	Period       date.Range
+	Metrics      map[date.Date]patient.MetricValues
+	Anomalies    []patient.Anomaly
+	LastModified time.Time
+}
+
+func (r PatientDetailsResponse) MarshalJSON() ([]byte, error) {
+	return patient.GeneratePatientDetailsResponse(r.Details, r.Period, r.Metrics, r.Anomalies)
+}
+
+type PatientDetailsService struct {
+	connectDb func() (*sql.DB, error)
+	clock     date.Clock
+}
+
+func NewPatientDetailsService(connectDb func() (*sql.DB, error), clock date.Clock) *PatientDetailsService {
+	return &PatientDetailsService{connectDb: connectDb, clock: clock}
+}
+
+func (s *PatientDetailsService) GetPatientDetails(ctx context.Context, request PatientDetailsRequest) (PatientDetailsResponse, error) {
+	// パラメーターの形式の検証
+	query, err := ParsePatientDetailsRequest(request)
+	if err != nil {
+		return PatientDetailsResponse{}, err
+	}
+	today := date.Today(s.clock)
+
+	// 相対指定が無ければDBに接続する前に期間を検証する
+	if !query.Relative() {
+		if _, err := query.Resolve(today, date.Date{}); err != nil {
+			return PatientDetailsResponse{}, err
+		}
+	}
+
+	// DB接続
+	db, err := s.connectDb()
+	if err != nil {
+		return PatientDetailsResponse{}, common.NewUpstreamUnavailableError(err)
+	}
+	defer db.Close()
+
+	params, err := resolveQuery(ctx, db, query, today)
+	if err != nil {
+		return PatientDetailsResponse{}, err
+	}
+
+	// SQLでデータを取得(派生指標と異常値の検出のため期間の前後のデータも取得する)
+	history, err := patient.GetPatientDetailsByPeriodAndArea(db, params.Area, date.Range{
+		Start: params.Period.Start.AddDays(-patient.AnomalyHistoryDays),
+		End:   params.Period.End.AddDays(patient.AnomalyHistoryDays),
+	})
+	if err != nil {
+		return PatientDetailsResponse{}, common.NewInternalError(err)
+	}
+	// 期間内にデータが無い場合もエラーにしないで空のデータを返す
+	patientDetails := []patient.Detail{}
+	for _, pd := range history {
+		if params.Period.Contains(pd.Date) {
+			patientDetails = append(patientDetails, pd)
+		}
+	}
+
+	res := PatientDetailsResponse{
+		Details:   patientDetails,
+		Period:    params.Period,
+		Anomalies: patient.DetectAnomalies(history, params.Period, patient.DefaultAnomalyOptions()),
+	}
+
+	// 派生指標(前週の7日間合計を使うため期間より前のデータも使う。AnomalyHistoryDays は MetricsHistoryDays より長い)
+	if len(params.Metrics) > 0 {
+		res.Metrics = patient.ComputeMetrics(history, params.Metrics, params.Period)
+	}
+
+	// 最終更新日時(最後に成功した取り込みの完了日時)
+	run, err := ingestion.GetLastSuccessfulRun(ctx, db)
+	if err != nil && !errors.Is(err, ingestion.ErrRunNotFound) {
+		log.Println(err)
+	}
+	if err == nil && run.FinishedAt != nil {
+		res.LastModified = *run.FinishedAt
+	}
+	return res, nil
+}
+
+// resolveQuery は相対指定を都道府県の最新のデータの日付を基準に解決して期間を検証する
+func resolveQuery(ctx context.Context, db *sql.DB, query PatientDetailsQuery, today date.Date) (PatientDetailParams, error) {
+	var latest date.Date
+	if query.Relative() {
+		var err error
+		latest, err = patient.GetLatestDateOfArea(ctx, db, query.Area)
+		if errors.Is(err, patient.ErrNoPatientDetails) {
+			return PatientDetailParams{}, common.NewNotFoundError(fmt.Errorf("patient details not found: area: %v", query.Area))
+		}
+		if err != nil {
+			return PatientDetailParams{}, common.NewInternalError(err)
+		}
+	}
+	return query.Resolve(today, latest)
+}
+
+// ParsePatientDetailsRequest はパラメーターの形式を検証する
+func ParsePatientDetailsRequest(request PatientDetailsRequest) (PatientDetailsQuery, error) {
+	query := PatientDetailsQuery{Area: request.Area}
+
+	var fieldErrors []common.FieldError
+	if request.Area == "" {
+		fieldErrors = append(fieldErrors, common.FieldError{Field: "area", Reason: common.FieldReasonRequired})
+	}
+	if request.Period != "" {
+		period, err := date.ParsePeriod(request.Period)
+		if err != nil {
+			fieldErrors = append(fieldErrors, common.FieldError{Field: "period", Reason: common.FieldReasonInvalidFormat})
+		}
+		query.Period = &period
+		if request.StartDate != "" || request.EndDate != "" {
+			fieldErrors = append(fieldErrors, common.FieldError{Field: "period", Reason: common.FieldReasonConflict})
+		}
+	} else {
+		var fieldError *common.FieldError
+		query.Start, fieldError = parseDateParam("start_date", request.StartDate)
+		if fieldError != nil {
+			fieldErrors = append(fieldErrors, *fieldError)
+		}
+		query.End, fieldError = parseDateParam("end_date", request.EndDate)
+		if fieldError != nil {
+			fieldErrors = append(fieldErrors, *fieldError)
+		}
+	}
+	if request.Metrics != "" {
+		metrics, err := patient.ParseMetrics(request.Metrics)
+		if err != nil {
+			fieldErrors = append(fieldErrors, common.FieldError{Field: "metrics", Reason: common.FieldReasonInvalidFormat})
+		}
+		query.Metrics = metrics
+	}
+	if len(fieldErrors) > 0 {
+		return PatientDetailsQuery{}, common.NewValidationError(fmt.Errorf("invalid parameter: area: %v, startDate: %v, endDate: %v, period: %v, metrics: %v", request.Area, request.StartDate, request.EndDate, request.Period, request.Metrics), fieldErrors...)
+	}
+	return query, nil
+}
+
+// Relative は期間の解決に最新のデータの日付が必要か
+func (q PatientDetailsQuery) Relative() bool {
+	if q.Period != nil {
+		return q.Period.Relative()
+	}
+	return q.Start.Relative() || q.End.Relative()
+}
+
+// Resolve は latest(最新のデータの日付)を基準に期間を決めて検証する。期間は集計の開始日から today の前日まで
+func (q PatientDetailsQuery) Resolve(today date.Date, latest date.Date) (PatientDetailParams, error) {
+	var period date.Range
+	startField, endField := "start_date", "end_date"
+	if q.Period != nil {
+		period = q.Period.Resolve(latest)
+		startField, endField = "period", "period"
+	} else {
+		period = date.Range{Start: q.Start.Resolve(latest), End: q.End.Resolve(latest)}
+	}
+
+	// 期間のチェック(集計の開始日から昨日まで)
+	var fieldErrors []common.FieldError
+	available := date.Range{Start: StartDateOfCountingPatientDetails, End: today.AddDays(-1)}
+	if !available.Contains(period.Start) {
+		fieldErrors = append(fieldErrors, common.FieldError{Field: startField, Reason: common.FieldReasonOutOfRange})
+	}
+	if !available.Contains(period.End) && (endField != startField || len(fieldErrors) == 0) {
+		fieldErrors = append(fieldErrors, common.FieldError{Field: endField, Reason: common.FieldReasonOutOfRange})
+	}
+	if len(fieldErrors) == 0 && period.Start.After(period.End) {
+		fieldErrors = append(fieldErrors, common.FieldError{Field: startField, Reason: common.FieldReasonInvalidOrder})
+	}
+	if len(fieldErrors) > 0 {
+		return PatientDetailParams{}, common.NewValidationError(fmt.Errorf("invalid specified period: %v", period), fieldErrors...)
+	}
+
+	return PatientDetailParams{
+		Area:    q.Area,
+		Period:  period,
+		Metrics: q.Metrics,
+	}, nil
+}
+
+// parseDateParam は yyyymmdd(yyyy-mm-dd も可)の日付か、latest, -30d のような相対指定を検証する
+func parseDateParam(field string, value string) (date.Expr, *common.FieldError) {
+	if value == "" {
+		return date.Expr{}, &common.FieldError{Field: field, Reason: common.FieldReasonRequired}
+	}
+	e, err := date.ParseExpr(value)
+	if err != nil {
+		return date.Expr{}, &common.FieldError{Field: field, Reason: common.FieldReasonInvalidFormat}
+	}
+	return e, nil
+}
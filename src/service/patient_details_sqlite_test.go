@@ -0,0 +1,45 @@
+//go:build cgo
+// +build cgo
+
+package service
+
+import (
+	"context"
+	"corona-api/src/modules/common"
+	"corona-api/src/modules/date"
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestPatientDetailsService_GetPatientDetails_SQLite(t *testing.T) {
+	const area = "東京都"
+	clock := date.FixedClock{Time: time.Date(2022, 10, 15, 0, 0, 0, 0, time.UTC)}
+	connectDb := newSQLiteConnectDb(t, area, weeklyValues(date.MustParse("2022-08-01"), 31))
+	s := NewPatientDetailsService(connectDb, clock)
+
+	// データがある期間
+	res, err := s.GetPatientDetails(context.Background(), PatientDetailsRequest{Area: area, StartDate: "2022-08-01", EndDate: "2022-08-07"})
+	assert.NoError(t, err)
+	assert.Len(t, res.Details, 7)
+
+	// 期間内にデータが無くてもエラーにしないで空のデータを返す
+	res, err = s.GetPatientDetails(context.Background(), PatientDetailsRequest{Area: area, StartDate: "2022-09-01", EndDate: "2022-09-07"})
+	assert.NoError(t, err)
+	assert.Empty(t, res.Details)
+	assert.Equal(t, date.Range{Start: date.MustParse("2022-09-01"), End: date.MustParse("2022-09-07")}, res.Period)
+	body, err := json.Marshal(res)
+	assert.NoError(t, err)
+	var got map[string]interface{}
+	assert.NoError(t, json.Unmarshal(body, &got))
+	assert.Equal(t, 0.0, got["sum"])
+	assert.Equal(t, 0.0, got["average"])
+	assert.Equal(t, 0.0, got["statistics"].(map[string]interface{})["count"])
+
+	// 相対指定は基準にする最新のデータの日付が無いので見つからない
+	_, err = s.GetPatientDetails(context.Background(), PatientDetailsRequest{Area: "北海道", Period: "last_4_weeks"})
+	status, _ := common.ErrorStatus(err)
+	assert.Equal(t, http.StatusNotFound, status, err)
+}
@@ -0,0 +1,175 @@
+package service
+
+import (
+	"context"
+	"corona-api/src/modules/analytics"
+	"corona-api/src/modules/common"
+	"corona-api/src/modules/date"
+	"corona-api/src/modules/patient"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strconv"
+)
+
+// RtWindowMaxDays は推定に使う日数の上限
+const RtWindowMaxDays = 28
+
+// RtRequest は実効再生産数取得のリクエスト(未検証の値)。期間の指定は感染者数詳細取得と同じ
+type RtRequest struct {
+	Area               string
+	StartDate          string
+	EndDate            string
+	Period             string
+	SerialIntervalMean string
+	SerialIntervalSD   string
+	Window             string
+	CredibleLevel      string
+}
+
+// RtResponse は実効再生産数取得のレスポンス
+type RtResponse struct {
+	Area           string                   `json:"area"`
+	Period         date.Range               `json:"period"`
+	Method         string                   `json:"method"`
+	SerialInterval analytics.SerialInterval `json:"serial_interval"`
+	Window         int                      `json:"window"`
+	CredibleLevel  float64                  `json:"credible_level"`
+	Estimates      []analytics.RtEstimate   `json:"estimates"`
+}
+
+type RtService struct {
+	connectDb func() (*sql.DB, error)
+	clock     date.Clock
+}
+
+func NewRtService(connectDb func() (*sql.DB, error), clock date.Clock) *RtService {
+	return &RtService{connectDb: connectDb, clock: clock}
+}
+
+func (s *RtService) GetRt(ctx context.Context, request RtRequest) (RtResponse, error) {
+	// パラメーターの形式の検証
+	query, opts, err := ParseRtRequest(request)
+	if err != nil {
+		return RtResponse{}, err
+	}
+	today := date.Today(s.clock)
+
+	// 相対指定が無ければDBに接続する前に期間を検証する
+	if !query.Relative() {
+		if _, err := query.Resolve(today, date.Date{}); err != nil {
+			return RtResponse{}, err
+		}
+	}
+
+	// DB接続
+	db, err := s.connectDb()
+	if err != nil {
+		return RtResponse{}, common.NewUpstreamUnavailableError(err)
+	}
+	defer db.Close()
+
+	params, err := resolveQuery(ctx, db, query, today)
+	if err != nil {
+		return RtResponse{}, err
+	}
+
+	// 期間の最初の日も推定できるように、発症間隔の分布と推定に使う日数の分だけ前から取得する
+	w, err := opts.SerialInterval.Discretize()
+	if err != nil {
+		return RtResponse{}, common.NewInternalError(err)
+	}
+	history := date.Range{Start: params.Period.Start.AddDays(-(len(w) + opts.Window)), End: params.Period.End}
+	patientDetails, err := patient.GetPatientDetailsByPeriodAndArea(db, params.Area, history)
+	if err != nil {
+		return RtResponse{}, common.NewInternalError(err)
+	}
+	if len(patientDetails) == 0 {
+		return RtResponse{}, common.NewNotFoundError(fmt.Errorf("patient details not found: area: %v", params.Area))
+	}
+
+	estimates, err := analytics.EstimateRt(analytics.NewSeries(patientDetails), opts)
+	if err != nil {
+		return RtResponse{}, common.NewInternalError(err)
+	}
+	res := RtResponse{
+		Area:           params.Area,
+		Period:         params.Period,
+		Method:         analytics.MethodCori,
+		SerialInterval: opts.SerialInterval,
+		Window:         opts.Window,
+		CredibleLevel:  opts.CredibleLevel,
+		Estimates:      []analytics.RtEstimate{},
+	}
+	for _, e := range estimates {
+		if params.Period.Contains(e.Date) {
+			res.Estimates = append(res.Estimates, e)
+		}
+	}
+	return res, nil
+}
+
+// ParseRtRequest はパラメーターの形式を検証する。推定の設定は指定が無ければ既定値にする
+func ParseRtRequest(request RtRequest) (PatientDetailsQuery, analytics.RtOptions, error) {
+	var fieldErrors []common.FieldError
+	query, err := ParsePatientDetailsRequest(PatientDetailsRequest{
+		Area:      request.Area,
+		StartDate: request.StartDate,
+		EndDate:   request.EndDate,
+		Period:    request.Period,
+	})
+	var validationError *common.ValidationError
+	if errors.As(err, &validationError) {
+		fieldErrors = append(fieldErrors, validationError.Fields...)
+	}
+
+	opts := analytics.DefaultRtOptions()
+	// 発症間隔の平均は1日より長い(1日ずらしたガンマ分布を使うため)
+	for _, fieldError := range []*common.FieldError{
+		parseFloatParam("si_mean", request.SerialIntervalMean, &opts.SerialInterval.Mean, func(v float64) bool { return v > 1 && v <= 30 }),
+		parseFloatParam("si_sd", request.SerialIntervalSD, &opts.SerialInterval.SD, func(v float64) bool { return v > 0 && v <= 30 }),
+		parseFloatParam("credible_level", request.CredibleLevel, &opts.CredibleLevel, func(v float64) bool { return v > 0 && v < 1 }),
+		parseIntParam("window", request.Window, &opts.Window, func(v int) bool { return v >= 1 && v <= RtWindowMaxDays }),
+	} {
+		if fieldError != nil {
+			fieldErrors = append(fieldErrors, *fieldError)
+		}
+	}
+
+	if len(fieldErrors) > 0 {
+		return PatientDetailsQuery{}, analytics.RtOptions{}, common.NewValidationError(fmt.Errorf("invalid parameter: %+v", request), fieldErrors...)
+	}
+	return query, opts, nil
+}
+
+// parseFloatParam は数値のパラメーターを検証して target に設定する。空なら target(既定値)のまま
+func parseFloatParam(field string, value string, target *float64, valid func(float64) bool) *common.FieldError {
+	if value == "" {
+		return nil
+	}
+	parsed, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return &common.FieldError{Field: field, Reason: common.FieldReasonInvalidFormat}
+	}
+	if !valid(parsed) {
+		return &common.FieldError{Field: field, Reason: common.FieldReasonOutOfRange}
+	}
+	*target = parsed
+	return nil
+}
+
+// parseIntParam は整数のパラメーターを検証して target に設定する。空なら target(既定値)のまま
+func parseIntParam(field string, value string, target *int, valid func(int) bool) *common.FieldError {
+	if value == "" {
+		return nil
+	}
+	parsed, err := strconv.Atoi(value)
+	if err != nil {
+		return &common.FieldError{Field: field, Reason: common.FieldReasonInvalidFormat}
+	}
+	if !valid(parsed) {
+		return &common.FieldError{Field: field, Reason: common.FieldReasonOutOfRange}
+	}
+	*target = parsed
+	return nil
+}
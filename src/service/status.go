@@ -0,0 +1,74 @@
+package service
+
+import (
+	"context"
+	"corona-api/src/modules/common"
+	"corona-api/src/modules/date"
+	"corona-api/src/modules/ingestion"
+	"corona-api/src/modules/patient"
+	"database/sql"
+	"errors"
+)
+
+// StatusAnomalyDays は取り込み状況で異常値を確認する最新の日数
+const StatusAnomalyDays = 14
+
+// StatusResponse はデータの取り込み状況
+// Anomalies は最新の StatusAnomalyDays 日間の全ての都道府県の異常値(報告の偏りなど)
+type StatusResponse struct {
+	LastSuccessfulRun *ingestion.Run       `json:"last_successful_run"`
+	LatestDate        date.Date            `json:"latest_date"`
+	Freshness         map[string]date.Date `json:"freshness"`
+	Anomalies         []patient.Anomaly    `json:"anomalies"`
+}
+
+type StatusService struct {
+	connectDb func() (*sql.DB, error)
+}
+
+func NewStatusService(connectDb func() (*sql.DB, error)) *StatusService {
+	return &StatusService{connectDb: connectDb}
+}
+
+func (s *StatusService) GetStatus(ctx context.Context) (StatusResponse, error) {
+	// DB接続
+	db, err := s.connectDb()
+	if err != nil {
+		return StatusResponse{}, common.NewUpstreamUnavailableError(err)
+	}
+	defer db.Close()
+
+	// 最後に成功した取り込み
+	var res StatusResponse
+	run, err := ingestion.GetLastSuccessfulRun(ctx, db)
+	if err != nil && !errors.Is(err, ingestion.ErrRunNotFound) {
+		return StatusResponse{}, common.NewInternalError(err)
+	}
+	if err == nil {
+		res.LastSuccessfulRun = &run
+	}
+
+	// 都道府県ごとの最新の日付
+	res.Freshness, err = patient.GetLatestDateByArea(ctx, db)
+	if err != nil {
+		return StatusResponse{}, common.NewInternalError(err)
+	}
+	for _, latestDate := range res.Freshness {
+		if latestDate.After(res.LatestDate) {
+			res.LatestDate = latestDate
+		}
+	}
+
+	// 最新の期間の異常値
+	res.Anomalies = []patient.Anomaly{}
+	if res.LatestDate.IsZero() {
+		return res, nil
+	}
+	period := date.Range{Start: res.LatestDate.AddDays(-(StatusAnomalyDays - 1)), End: res.LatestDate}
+	patientDetails, err := patient.GetPatientDetailsByPeriod(ctx, db, date.Range{Start: period.Start.AddDays(-patient.AnomalyHistoryDays), End: period.End})
+	if err != nil {
+		return StatusResponse{}, common.NewInternalError(err)
+	}
+	res.Anomalies = patient.DetectAnomalies(patientDetails, period, patient.DefaultAnomalyOptions())
+	return res, nil
+}
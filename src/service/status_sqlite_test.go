@@ -0,0 +1,59 @@
+//go:build cgo
+// +build cgo
+
+package service
+
+import (
+	"context"
+	"corona-api/src/modules/date"
+	"corona-api/src/modules/ingestion"
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestStatusService_GetStatus_SQLite(t *testing.T) {
+	const area = "東京都"
+	connectDb := newSQLiteConnectDb(t, area, weeklyValues(date.MustParse("2022-08-01"), 31))
+	db, err := connectDb()
+	assert.NoError(t, err)
+	defer db.Close()
+	ctx := context.Background()
+	_, err = db.Exec("INSERT INTO patient_details (date, area, value, country) VALUES (?,?,?,?)", date.MustParse("2022-08-20"), "北海道", 100, "Japan")
+	assert.NoError(t, err)
+
+	s := NewStatusService(connectDb)
+
+	// 取り込みの履歴が無い場合
+	res, err := s.GetStatus(ctx)
+	assert.NoError(t, err)
+	assert.Nil(t, res.LastSuccessfulRun)
+
+	// 成功した取り込みと、より新しい失敗した取り込み
+	succeeded, err := ingestion.StartRun(ctx, db, "patient_details/20220831.csv")
+	assert.NoError(t, err)
+	assert.NoError(t, ingestion.FinishRun(ctx, db, succeeded, nil))
+	failed, err := ingestion.StartRun(ctx, db, "patient_details/20220901.csv")
+	assert.NoError(t, err)
+	assert.NoError(t, ingestion.FinishRun(ctx, db, failed, errors.New("download error")))
+	_, err = db.Exec("UPDATE ingestion_runs SET finished_at = ? WHERE id = ?", succeeded.FinishedAt.Add(time.Hour), failed.ID)
+	assert.NoError(t, err)
+
+	res, err = s.GetStatus(ctx)
+	assert.NoError(t, err)
+	if assert.NotNil(t, res.LastSuccessfulRun) {
+		assert.Equal(t, succeeded.ID, res.LastSuccessfulRun.ID)
+		assert.Equal(t, ingestion.RunStatusSucceeded, res.LastSuccessfulRun.Status)
+	}
+	// 都道府県ごとの最新の日付と、全体の最新の日付
+	assert.Equal(t, map[string]date.Date{area: date.MustParse("2022-08-31"), "北海道": date.MustParse("2022-08-20")}, res.Freshness)
+	assert.Equal(t, date.MustParse("2022-08-31"), res.LatestDate)
+	assert.NotNil(t, res.Anomalies)
+
+	// 感染者数詳細の最終更新日時も最後に成功した取り込みの完了日時
+	details, err := NewPatientDetailsService(connectDb, date.FixedClock{Time: time.Date(2022, 10, 15, 0, 0, 0, 0, time.UTC)}).
+		GetPatientDetails(ctx, PatientDetailsRequest{Area: area, Period: "last_4_weeks"})
+	assert.NoError(t, err)
+	assert.True(t, succeeded.FinishedAt.Equal(details.LastModified), details.LastModified)
+}
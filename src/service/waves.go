@@ -0,0 +1,151 @@
+package service
+
+import (
+	"context"
+	"corona-api/src/modules/analytics"
+	"corona-api/src/modules/common"
+	"corona-api/src/modules/date"
+	"corona-api/src/modules/patient"
+	"database/sql"
+	"errors"
+	"fmt"
+)
+
+const (
+	// WaveSmoothingWindowMaxDays は平滑化の日数の上限
+	WaveSmoothingWindowMaxDays = 28
+	// WaveMinDistanceMaxDays はピークの間隔の下限に指定できる日数の上限
+	WaveMinDistanceMaxDays = 365
+)
+
+// WavesRequest は流行の波の取得のリクエスト(未検証の値)
+// 期間の指定は感染者数詳細取得と同じ。期間を指定しなければ全ての期間にする
+type WavesRequest struct {
+	Area            string
+	StartDate       string
+	EndDate         string
+	Period          string
+	SmoothingWindow string
+	MinProminence   string
+	MinDistance     string
+	MinPeak         string
+}
+
+// WavesResponse は流行の波の取得のレスポンス
+type WavesResponse struct {
+	Area    string                `json:"area"`
+	Period  date.Range            `json:"period"`
+	Options analytics.WaveOptions `json:"options"`
+	Waves   []analytics.Wave      `json:"waves"`
+}
+
+type WavesService struct {
+	connectDb func() (*sql.DB, error)
+	clock     date.Clock
+}
+
+func NewWavesService(connectDb func() (*sql.DB, error), clock date.Clock) *WavesService {
+	return &WavesService{connectDb: connectDb, clock: clock}
+}
+
+func (s *WavesService) GetWaves(ctx context.Context, request WavesRequest) (WavesResponse, error) {
+	// パラメーターの形式の検証
+	query, opts, err := ParseWavesRequest(request)
+	if err != nil {
+		return WavesResponse{}, err
+	}
+	today := date.Today(s.clock)
+	allPeriods := request.StartDate == "" && request.EndDate == "" && request.Period == ""
+
+	// 相対指定が無ければDBに接続する前に期間を検証する
+	if !allPeriods && !query.Relative() {
+		if _, err := query.Resolve(today, date.Date{}); err != nil {
+			return WavesResponse{}, err
+		}
+	}
+
+	// DB接続
+	db, err := s.connectDb()
+	if err != nil {
+		return WavesResponse{}, common.NewUpstreamUnavailableError(err)
+	}
+	defer db.Close()
+
+	var patientDetails []patient.Detail
+	var period date.Range
+	if allPeriods {
+		patientDetails, err = patient.GetPatientDetailsByArea(ctx, db, query.Area)
+		if err != nil {
+			return WavesResponse{}, common.NewInternalError(err)
+		}
+	} else {
+		params, err := resolveQuery(ctx, db, query, today)
+		if err != nil {
+			return WavesResponse{}, err
+		}
+		period = params.Period
+		patientDetails, err = patient.GetPatientDetailsByPeriodAndArea(db, params.Area, params.Period)
+		if err != nil {
+			return WavesResponse{}, common.NewInternalError(err)
+		}
+	}
+	if len(patientDetails) == 0 {
+		return WavesResponse{}, common.NewNotFoundError(fmt.Errorf("patient details not found: area: %v", query.Area))
+	}
+
+	series := analytics.NewSeries(patientDetails)
+	if allPeriods {
+		period = series.Range()
+	}
+	waves, err := analytics.DetectWaves(series, opts)
+	if err != nil {
+		return WavesResponse{}, common.NewInternalError(err)
+	}
+	return WavesResponse{
+		Area:    query.Area,
+		Period:  period,
+		Options: opts,
+		Waves:   waves,
+	}, nil
+}
+
+// ParseWavesRequest はパラメーターの形式を検証する。検出の設定は指定が無ければ既定値にする
+func ParseWavesRequest(request WavesRequest) (PatientDetailsQuery, analytics.WaveOptions, error) {
+	var fieldErrors []common.FieldError
+	var query PatientDetailsQuery
+	if request.StartDate == "" && request.EndDate == "" && request.Period == "" {
+		query.Area = request.Area
+		if request.Area == "" {
+			fieldErrors = append(fieldErrors, common.FieldError{Field: "area", Reason: common.FieldReasonRequired})
+		}
+	} else {
+		var err error
+		query, err = ParsePatientDetailsRequest(PatientDetailsRequest{
+			Area:      request.Area,
+			StartDate: request.StartDate,
+			EndDate:   request.EndDate,
+			Period:    request.Period,
+		})
+		var validationError *common.ValidationError
+		if errors.As(err, &validationError) {
+			fieldErrors = append(fieldErrors, validationError.Fields...)
+		}
+	}
+
+	opts := analytics.DefaultWaveOptions()
+	for _, fieldError := range []*common.FieldError{
+		parseIntParam("smoothing_window", request.SmoothingWindow, &opts.SmoothingWindow, func(v int) bool { return v >= 1 && v <= WaveSmoothingWindowMaxDays }),
+		parseFloatParam("min_prominence", request.MinProminence, &opts.MinProminence, func(v float64) bool { return v >= 0 && v <= 1 }),
+		parseIntParam("min_distance", request.MinDistance, &opts.MinDistance, func(v int) bool { return v >= 0 && v <= WaveMinDistanceMaxDays }),
+		parseFloatParam("min_peak", request.MinPeak, &opts.MinPeak, func(v float64) bool { return v >= 0 }),
+	} {
+		if fieldError != nil {
+			fieldErrors = append(fieldErrors, *fieldError)
+		}
+	}
+
+	if len(fieldErrors) > 0 {
+		return PatientDetailsQuery{}, analytics.WaveOptions{}, common.NewValidationError(fmt.Errorf("invalid parameter: %+v", request), fieldErrors...)
+	}
+	return query, opts, nil
+}
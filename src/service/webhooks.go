@@ -0,0 +1,144 @@
+package service
+
+import (
+	"context"
+	"corona-api/src/middleware"
+	"corona-api/src/modules/common"
+	"corona-api/src/modules/outbox"
+	"corona-api/src/modules/subscription"
+	"database/sql"
+	"errors"
+	"fmt"
+)
+
+const (
+	DeadLetterListLimit = 100
+)
+
+// CreateWebhookRequest は Webhook 登録のリクエスト
+type CreateWebhookRequest struct {
+	URL string `json:"url"`
+}
+
+// CreateWebhookResponse は Webhook 登録のレスポンス
+// 署名鍵は登録時だけ返す
+type CreateWebhookResponse struct {
+	outbox.Endpoint
+	Secret string `json:"secret"`
+}
+
+type WebhooksResponse struct {
+	Webhooks []outbox.Endpoint `json:"webhooks"`
+}
+
+type DeadLettersResponse struct {
+	DeadLetters []outbox.DeadLetter `json:"dead_letters"`
+}
+
+type WebhookService struct {
+	connectDb func() (*sql.DB, error)
+}
+
+func NewWebhookService(connectDb func() (*sql.DB, error)) *WebhookService {
+	return &WebhookService{connectDb: connectDb}
+}
+
+func (s *WebhookService) CreateWebhook(ctx context.Context, request CreateWebhookRequest) (CreateWebhookResponse, error) {
+	// パラメーターの検証
+	if fieldError := validateWebhookURL(ctx, "url", request.URL); fieldError != nil {
+		return CreateWebhookResponse{}, common.NewValidationError(fmt.Errorf("invalid webhook url: %v", request.URL), *fieldError)
+	}
+	secret, err := subscription.NewSecret()
+	if err != nil {
+		return CreateWebhookResponse{}, common.NewInternalError(err)
+	}
+
+	// DB接続
+	db, err := s.connectDb()
+	if err != nil {
+		return CreateWebhookResponse{}, common.NewUpstreamUnavailableError(err)
+	}
+	defer db.Close()
+
+	endpoint := outbox.Endpoint{URL: request.URL, Secret: secret}
+	if err := outbox.CreateEndpoint(ctx, db, &endpoint); err != nil {
+		return CreateWebhookResponse{}, common.NewInternalError(err)
+	}
+	return CreateWebhookResponse{Endpoint: endpoint, Secret: secret}, nil
+}
+
+func (s *WebhookService) ListWebhooks(ctx context.Context) (WebhooksResponse, error) {
+	// DB接続
+	db, err := s.connectDb()
+	if err != nil {
+		return WebhooksResponse{}, common.NewUpstreamUnavailableError(err)
+	}
+	defer db.Close()
+
+	endpoints, err := outbox.GetEndpoints(ctx, db)
+	if err != nil {
+		return WebhooksResponse{}, common.NewInternalError(err)
+	}
+	return WebhooksResponse{Webhooks: endpoints}, nil
+}
+
+func (s *WebhookService) DeleteWebhook(ctx context.Context, id string) error {
+	endpointID, err := parseID(id)
+	if err != nil {
+		return err
+	}
+
+	// DB接続
+	db, err := s.connectDb()
+	if err != nil {
+		return common.NewUpstreamUnavailableError(err)
+	}
+	defer db.Close()
+
+	err = outbox.DeleteEndpoint(ctx, middleware.NewTxAdmin(db), endpointID)
+	if errors.Is(err, outbox.ErrEndpointNotFound) {
+		return common.NewNotFoundError(fmt.Errorf("webhook not found: id: %v", endpointID))
+	}
+	if err != nil {
+		return common.NewInternalError(err)
+	}
+	return nil
+}
+
+func (s *WebhookService) ListDeadLetters(ctx context.Context) (DeadLettersResponse, error) {
+	// DB接続
+	db, err := s.connectDb()
+	if err != nil {
+		return DeadLettersResponse{}, common.NewUpstreamUnavailableError(err)
+	}
+	defer db.Close()
+
+	deadLetters, err := outbox.GetDeadLetters(ctx, db, DeadLetterListLimit)
+	if err != nil {
+		return DeadLettersResponse{}, common.NewInternalError(err)
+	}
+	return DeadLettersResponse{DeadLetters: deadLetters}, nil
+}
+
+func (s *WebhookService) ReplayDeadLetter(ctx context.Context, id string) error {
+	deliveryID, err := parseID(id)
+	if err != nil {
+		return err
+	}
+
+	// DB接続
+	db, err := s.connectDb()
+	if err != nil {
+		return common.NewUpstreamUnavailableError(err)
+	}
+	defer db.Close()
+
+	err = outbox.Replay(ctx, db, deliveryID)
+	if errors.Is(err, outbox.ErrDeadLetterNotFound) {
+		return common.NewNotFoundError(fmt.Errorf("dead letter not found: id: %v", deliveryID))
+	}
+	if err != nil {
+		return common.NewInternalError(err)
+	}
+	return nil
+}
@@ -0,0 +1,65 @@
+package transport
+
+import (
+	"context"
+	"corona-api/src/modules/common"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+)
+
+// Request はAPI Gateway・ALB・net/httpなどの呼び出し元に依存しないHTTPリクエスト
+type Request struct {
+	Method     string
+	Path       string
+	Query      url.Values
+	Headers    http.Header
+	PathParams map[string]string
+	Body       []byte
+	SourceIP   string
+	RequestID  string
+}
+
+// Response は呼び出し元に依存しないHTTPレスポンス
+type Response struct {
+	StatusCode int
+	Headers    http.Header
+	Body       []byte
+}
+
+// Handler は各呼び出し元のアダプタから実行されるハンドラ
+type Handler func(ctx context.Context, request Request) (Response, error)
+
+// Language はクエリパラメータ・ヘッダからレスポンスの言語を決定する
+func (r Request) Language() common.Language {
+	return common.DetectLanguage(r.Query.Get("lang"), r.Headers.Get("Accept-Language"))
+}
+
+func JSONResponse(statusCode int, body interface{}) (Response, error) {
+	bytes, err := json.Marshal(body)
+	if err != nil {
+		return Response{}, fmt.Errorf("JSON marshal error: body: %v, %v ", body, err)
+	}
+	return Response{
+		StatusCode: statusCode,
+		Headers:    http.Header{"Content-Type": {"application/json"}},
+		Body:       bytes,
+	}, nil
+}
+
+func ErrorResponse(err error, lang common.Language) (Response, error) {
+	// ロギング
+	log.Println(err)
+
+	statusCode, body := common.ErrorResponseBody(err, lang)
+	return Response{
+		StatusCode: statusCode,
+		Headers: http.Header{
+			"Content-Type":     {"application/json"},
+			"Content-Language": {string(lang)},
+		},
+		Body: body,
+	}, nil
+}